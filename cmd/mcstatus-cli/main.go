@@ -0,0 +1,177 @@
+// mcstatus-cli 在終端中查詢 Minecraft 伺服器狀態，不需要運行 HTTP 服務
+package main
+
+import (
+	"backend/mcstatus"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// result 是單個伺服器的查詢結果，也是 JSON 模式下每行輸出的內容
+type result struct {
+	Address   string                  `json:"address"`
+	Edition   string                  `json:"edition"`
+	Online    bool                    `json:"online"`
+	Time      time.Time               `json:"time"`
+	IP        string                  `json:"ip,omitempty"`
+	Port      int                     `json:"port,omitempty"`
+	LatencyMS int64                   `json:"latency_ms,omitempty"`
+	Java      *mcstatus.ServerStatus  `json:"java,omitempty"`
+	Bedrock   *mcstatus.BedrockStatus `json:"bedrock,omitempty"`
+	Code      mcstatus.ErrorCode      `json:"code,omitempty"`
+	Error     string                  `json:"error,omitempty"`
+}
+
+func main() {
+	edition := flag.String("edition", mcstatus.EditionJava, "server edition: java or bedrock")
+	jsonOutput := flag.Bool("json", false, "print one JSON object per server instead of a table")
+	interval := flag.Duration("interval", 0, "query again at this interval until interrupted (e.g. 10s)")
+	timeout := flag.Duration("timeout", 5*time.Second, "timeout of each query")
+	protocol := flag.Int("protocol", 0, "protocol version announced in the handshake (Java only)")
+	verbose := flag.Bool("v", false, "print query logs to stderr")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] address...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *edition != mcstatus.EditionJava && *edition != mcstatus.EditionBedrock {
+		fmt.Fprintf(os.Stderr, "unsupported edition: %s\n", *edition)
+		os.Exit(2)
+	}
+	if !*verbose {
+		log.SetOutput(io.Discard)
+	}
+
+	client := mcstatus.NewClient(mcstatus.WithTimeout(*timeout), mcstatus.WithProtocolVersion(int32(*protocol)))
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	output := printTable
+	if *jsonOutput {
+		output = printJSON
+	}
+
+	// 只查詢一次時，任何伺服器查詢失敗都以非零狀態退出
+	if *interval <= 0 {
+		results := queryAll(ctx, client, *edition, flag.Args())
+		output(results, false)
+		for _, r := range results {
+			if !r.Online {
+				os.Exit(1)
+			}
+		}
+		return
+	}
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+	for {
+		output(queryAll(ctx, client, *edition, flag.Args()), true)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// queryAll 同時查詢所有伺服器，結果的順序與 addresses 相同
+func queryAll(ctx context.Context, client *mcstatus.Client, edition string, addresses []string) []result {
+	results := make([]result, len(addresses))
+	var wg sync.WaitGroup
+	for i, address := range addresses {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = query(ctx, client, edition, address)
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// query 查詢單個伺服器
+func query(ctx context.Context, client *mcstatus.Client, edition, address string) result {
+	r := result{Address: address, Edition: edition, Time: time.Now().UTC()}
+	var err error
+	switch edition {
+	case mcstatus.EditionBedrock:
+		r.Bedrock, err = client.BedrockStatusContext(ctx, address)
+		if err == nil {
+			r.IP, r.Port, r.LatencyMS = r.Bedrock.IP, r.Bedrock.Port, r.Bedrock.Latency.Milliseconds()
+		}
+	default:
+		r.Java, err = client.StatusContext(ctx, address)
+		if err == nil {
+			r.IP, r.Port, r.LatencyMS = r.Java.IP, r.Java.Port, r.Java.Latency.Milliseconds()
+		}
+	}
+	if err != nil {
+		r.Code = mcstatus.ErrorCodeOf(err)
+		r.Error = err.Error()
+		return r
+	}
+	r.Online = true
+	return r
+}
+
+// printJSON 每個伺服器輸出一行 JSON，便於在監視模式下由其他程序逐行讀取
+func printJSON(results []result, _ bool) {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetEscapeHTML(false)
+	for _, r := range results {
+		enc.Encode(r)
+	}
+}
+
+// printTable 以表格輸出結果，監視模式下在終端中每次刷新前清屏
+func printTable(results []result, watch bool) {
+	if watch {
+		if fi, err := os.Stdout.Stat(); err == nil && fi.Mode()&os.ModeCharDevice != 0 {
+			fmt.Print("\033[H\033[2J")
+		}
+		fmt.Println(time.Now().Format("2006-01-02 15:04:05"))
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ADDRESS\tSTATUS\tVERSION\tPLAYERS\tLATENCY\tMOTD")
+	for _, r := range results {
+		if !r.Online {
+			fmt.Fprintf(w, "%s\toffline\t-\t-\t-\t%s\n", r.Address, r.Error)
+			continue
+		}
+		var version, players, motd string
+		if r.Java != nil {
+			version = r.Java.Version.Name
+			players = fmt.Sprintf("%d/%d", r.Java.Players.Online, r.Java.Players.Max)
+			motd = mcstatus.RenderPlainText(r.Java.Description.Components)
+		} else {
+			version = r.Bedrock.Version
+			players = fmt.Sprintf("%d/%d", r.Bedrock.Players.Online, r.Bedrock.Players.Max)
+			motd = mcstatus.RenderPlainText(r.Bedrock.MOTDComponents)
+		}
+		fmt.Fprintf(w, "%s\tonline\t%s\t%s\t%dms\t%s\n", r.Address, tableCell(version), players, r.LatencyMS, tableCell(motd))
+	}
+	w.Flush()
+}
+
+// tableCell 將伺服器提供的文本轉換為單行的表格單元格
+// 去除控制字符，避免伺服器在終端中注入轉義序列，並將換行等空白合併為一個空格
+func tableCell(s string) string {
+	return strings.Join(strings.Fields(mcstatus.StripControl(s)), " ")
+}
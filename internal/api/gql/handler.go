@@ -0,0 +1,57 @@
+package gql
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/graphql-go/handler"
+)
+
+// Handler 返回處理 GraphQL 請求的 HTTP 處理器，瀏覽器直接訪問時顯示 GraphiQL
+// 每個請求中所有字段合計最多查詢 maxServers 個伺服器，避免通過別名重複 servers 字段放大出站查詢
+func Handler() http.Handler {
+	h := handler.New(&handler.Config{
+		Schema:   &Schema,
+		Pretty:   true,
+		GraphiQL: true,
+	})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		budget := new(atomic.Int64)
+		budget.Store(maxServers)
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), budgetKey{}, budget)))
+	})
+}
+
+// budgetKey 是請求上下文中剩餘可查詢伺服器數的鍵
+type budgetKey struct{}
+
+// clientKey 是請求上下文中客戶端 IP 的鍵
+type clientKey struct{}
+
+// WithClient 在請求上下文中記錄發起請求的客戶端 IP，用於限流和統計
+func WithClient(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientKey{}, ip)
+}
+
+// clientFrom 返回通過 WithClient 記錄的客戶端 IP
+func clientFrom(ctx context.Context) string {
+	ip, _ := ctx.Value(clientKey{}).(string)
+	return ip
+}
+
+// reserve 從請求的額度中扣除 n 個伺服器，超出額度時返回 errTooManyServers
+// 不是通過 Handler 發起的查詢沒有共用的額度，只檢查單個字段
+func reserve(ctx context.Context, n int) error {
+	budget, ok := ctx.Value(budgetKey{}).(*atomic.Int64)
+	if !ok {
+		if n > maxServers {
+			return errTooManyServers
+		}
+		return nil
+	}
+	if budget.Add(-int64(n)) < 0 {
+		return errTooManyServers
+	}
+	return nil
+}
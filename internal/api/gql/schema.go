@@ -0,0 +1,267 @@
+// Package gql 提供 GraphQL 查詢介面，讓前端在一次請求中只獲取需要的字段
+package gql
+
+import (
+	"backend/mcstatus"
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/graphql-go/graphql"
+)
+
+// 批量查詢的限制
+const (
+	maxServers     = 20 // 單次請求中所有字段（包括別名）合計查詢的最大伺服器數，與 REST API 的批量查詢相同
+	maxConcurrency = 8  // 同時進行的最大查詢數
+)
+
+var errTooManyServers = fmt.Errorf("單次請求最多查詢 %d 個伺服器", maxServers)
+
+// serverResult 是單個伺服器的查詢結果
+type serverResult struct {
+	Address string
+	Online  bool
+	Edition string
+	Java    *mcstatus.ServerStatus
+	Bedrock *mcstatus.BedrockStatus
+	Error   *queryError
+}
+
+// queryError 是查詢失敗的原因，code 與 REST API 的錯誤類別相同
+type queryError struct {
+	Code    string
+	Message string
+}
+
+var editionEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name:        "Edition",
+	Description: "伺服器版本",
+	Values: graphql.EnumValueConfigMap{
+		"JAVA":    {Value: mcstatus.EditionJava},
+		"BEDROCK": {Value: mcstatus.EditionBedrock},
+		"AUTO":    {Value: "auto", Description: "依次嘗試 Java 版和基岩版"},
+	},
+})
+
+var motdComponentType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "MOTDComponent",
+	Description: "MOTD 中一段具有相同格式的文本",
+	Fields: graphql.Fields{
+		"text":          {Type: graphql.NewNonNull(graphql.String)},
+		"color":         {Type: graphql.String},
+		"bold":          {Type: graphql.NewNonNull(graphql.Boolean)},
+		"italic":        {Type: graphql.NewNonNull(graphql.Boolean)},
+		"underlined":    {Type: graphql.NewNonNull(graphql.Boolean)},
+		"strikethrough": {Type: graphql.NewNonNull(graphql.Boolean)},
+		"obfuscated":    {Type: graphql.NewNonNull(graphql.Boolean)},
+	},
+})
+
+var javaStatusType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "JavaStatus",
+	Description: "Java 版伺服器狀態",
+	Fields: graphql.Fields{
+		"version": {Type: graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+			Name: "JavaVersion",
+			Fields: graphql.Fields{
+				"name":     {Type: graphql.NewNonNull(graphql.String)},
+				"protocol": {Type: graphql.NewNonNull(graphql.Int)},
+			},
+		}))},
+		"players": {Type: graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+			Name: "JavaPlayers",
+			Fields: graphql.Fields{
+				"online": {Type: graphql.NewNonNull(graphql.Int)},
+				"max":    {Type: graphql.NewNonNull(graphql.Int)},
+				"sample": {Type: graphql.NewList(graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+					Name: "Player",
+					Fields: graphql.Fields{
+						"name": {Type: graphql.NewNonNull(graphql.String)},
+						"id":   {Type: graphql.NewNonNull(graphql.String)},
+					},
+				})))},
+			},
+		}))},
+		"motd": {
+			Type:        graphql.NewNonNull(graphql.String),
+			Description: "去除格式後的 MOTD",
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return mcstatus.RenderPlainText(p.Source.(*mcstatus.ServerStatus).Description.Components), nil
+			},
+		},
+		"motdHtml": {
+			Type:        graphql.NewNonNull(graphql.String),
+			Description: "渲染後的 MOTD HTML",
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return mcstatus.RenderHTML(p.Source.(*mcstatus.ServerStatus).Description.Components), nil
+			},
+		},
+		"motdComponents": {
+			Type: graphql.NewList(graphql.NewNonNull(motdComponentType)),
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return p.Source.(*mcstatus.ServerStatus).Description.Components, nil
+			},
+		},
+		"favicon":             {Type: graphql.String, Description: "伺服器圖標（data URL）"},
+		"iconHash":            {Type: graphql.String, Description: "伺服器圖標的 SHA-256 哈希值"},
+		"compatibleVersions":  {Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+		"enforcesSecureChat":  {Type: graphql.Boolean},
+		"preventsChatReports": {Type: graphql.Boolean},
+		"software": {Type: graphql.NewObject(graphql.ObjectConfig{
+			Name:        "Software",
+			Description: "推測的伺服器實現",
+			Fields: graphql.Fields{
+				"name":  {Type: graphql.NewNonNull(graphql.String)},
+				"proxy": {Type: graphql.NewNonNull(graphql.Boolean)},
+			},
+		})},
+		"forge": {Type: graphql.NewObject(graphql.ObjectConfig{
+			Name:        "ForgeInfo",
+			Description: "Forge 模組信息",
+			Fields: graphql.Fields{
+				"type":              {Type: graphql.NewNonNull(graphql.String)},
+				"fmlNetworkVersion": {Type: graphql.NewNonNull(graphql.Int)},
+				"truncated":         {Type: graphql.NewNonNull(graphql.Boolean)},
+				"mods": {Type: graphql.NewList(graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+					Name: "ForgeMod",
+					Fields: graphql.Fields{
+						"id":         {Type: graphql.NewNonNull(graphql.String)},
+						"version":    {Type: graphql.String},
+						"serverOnly": {Type: graphql.NewNonNull(graphql.Boolean)},
+					},
+				})))},
+			},
+		})},
+	},
+})
+
+var bedrockStatusType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "BedrockStatus",
+	Description: "基岩版伺服器狀態",
+	Fields: graphql.Fields{
+		"gameType":       {Type: graphql.NewNonNull(graphql.String)},
+		"motd":           {Type: graphql.NewNonNull(graphql.String)},
+		"motdComponents": {Type: graphql.NewList(graphql.NewNonNull(motdComponentType))},
+		"protocol":       {Type: graphql.NewNonNull(graphql.Int)},
+		"version":        {Type: graphql.NewNonNull(graphql.String)},
+		"players": {Type: graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+			Name: "BedrockPlayers",
+			Fields: graphql.Fields{
+				"online": {Type: graphql.NewNonNull(graphql.Int)},
+				"max":    {Type: graphql.NewNonNull(graphql.Int)},
+			},
+		}))},
+		"serverId": {Type: graphql.String},
+		"mapName":  {Type: graphql.String},
+		"gameMode": {Type: graphql.String},
+		"portIPv4": {Type: graphql.Int},
+		"portIPv6": {Type: graphql.Int},
+	},
+})
+
+var serverResultType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "ServerResult",
+	Description: "單個伺服器的查詢結果，查詢失敗時 online 為 false 並在 error 中說明原因",
+	Fields: graphql.Fields{
+		"address": {Type: graphql.NewNonNull(graphql.String)},
+		"online":  {Type: graphql.NewNonNull(graphql.Boolean)},
+		"edition": {Type: editionEnum, Description: "實際回應的版本"},
+		"java":    {Type: javaStatusType},
+		"bedrock": {Type: bedrockStatusType},
+		"error": {Type: graphql.NewObject(graphql.ObjectConfig{
+			Name: "QueryError",
+			Fields: graphql.Fields{
+				"code":    {Type: graphql.NewNonNull(graphql.String)},
+				"message": {Type: graphql.NewNonNull(graphql.String)},
+			},
+		})},
+	},
+})
+
+// Schema 是 GraphQL 查詢的結構定義
+var Schema = mustSchema(graphql.SchemaConfig{
+	Query: graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"server": {
+				Type:        graphql.NewNonNull(serverResultType),
+				Description: "查詢單個伺服器的狀態",
+				Args: graphql.FieldConfigArgument{
+					"address": {Type: graphql.NewNonNull(graphql.String)},
+					"edition": {Type: editionEnum, DefaultValue: mcstatus.EditionJava},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					if err := reserve(p.Context, 1); err != nil {
+						return nil, err
+					}
+					return query(p.Context, p.Args["address"].(string), p.Args["edition"].(string)), nil
+				},
+			},
+			"servers": {
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(serverResultType))),
+				Description: "並發查詢多個伺服器的狀態，結果順序與 addresses 相同",
+				Args: graphql.FieldConfigArgument{
+					"addresses": {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String)))},
+					"edition":   {Type: editionEnum, DefaultValue: mcstatus.EditionJava},
+				},
+				Resolve: resolveServers,
+			},
+		},
+	}),
+})
+
+// mustSchema 創建 GraphQL 結構，定義錯誤時直接 panic
+func mustSchema(config graphql.SchemaConfig) graphql.Schema {
+	schema, err := graphql.NewSchema(config)
+	if err != nil {
+		panic(err)
+	}
+	return schema
+}
+
+// resolveServers 並發查詢多個伺服器
+func resolveServers(p graphql.ResolveParams) (any, error) {
+	addresses := p.Args["addresses"].([]any)
+	if err := reserve(p.Context, len(addresses)); err != nil {
+		return nil, err
+	}
+	edition := p.Args["edition"].(string)
+
+	results := make([]*serverResult, len(addresses))
+	sem := make(chan struct{}, maxConcurrency)
+	var wg sync.WaitGroup
+	for i, address := range addresses {
+		wg.Add(1)
+		go func(i int, address string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i] = query(p.Context, address, edition)
+		}(i, address.(string))
+	}
+	wg.Wait()
+	return results, nil
+}
+
+// query 通過狀態緩存查詢伺服器狀態，失敗時在結果中記錄錯誤，ctx 是 GraphQL 請求的上下文
+func query(ctx context.Context, address, edition string) *serverResult {
+	result := &serverResult{Address: address}
+	status, _, err := mcstatus.GetCachedStatusContext(ctx, edition, address, mcstatus.CacheOptions{Client: clientFrom(ctx)})
+	if err != nil {
+		code := mcstatus.ErrorCodeOf(err)
+		if code == "" {
+			code = "INTERNAL_ERROR"
+		}
+		result.Error = &queryError{Code: string(code), Message: err.Error()}
+		return result
+	}
+
+	result.Online = true
+	result.Java, result.Bedrock = status.Java, status.Bedrock
+	result.Edition = mcstatus.EditionJava
+	if result.Bedrock != nil {
+		result.Edition = mcstatus.EditionBedrock
+	}
+	return result
+}
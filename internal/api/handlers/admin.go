@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// adminToken 是管理員令牌，為空時停用所有管理員功能
+var adminToken string
+
+// SetAdminToken 設置管理員令牌
+func SetAdminToken(token string) {
+	adminToken = token
+}
+
+// refreshAdminOnly 為 true 時只有管理員可以通過 refresh 參數強制刷新緩存
+var refreshAdminOnly bool
+
+// SetRefreshAdminOnly 設置是否只允許管理員強制刷新緩存
+func SetRefreshAdminOnly(adminOnly bool) {
+	refreshAdminOnly = adminOnly
+}
+
+// isAdmin 檢查請求是否帶有正確的管理員令牌
+// 令牌可以通過 X-Admin-Token 標頭或 Authorization: Bearer 標頭傳遞
+func isAdmin(c *gin.Context) bool {
+	if adminToken == "" {
+		return false
+	}
+
+	token := c.GetHeader("X-Admin-Token")
+	if token == "" {
+		token = strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) == 1
+}
+
+// RequireAdmin 只允許帶有管理員令牌的請求，用於管理員專用的路由
+func RequireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !isAdmin(c) {
+			abortWithError(c, codeForbidden, "需要管理員權限")
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"backend/mcstatus"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultAvatarSize 是頭像尺寸的預設值
+const defaultAvatarSize = 64
+
+// avatarCacheControl 是頭像的緩存時間，皮膚很少變化
+const avatarCacheControl = "public, max-age=3600"
+
+// avatarQuery 是 GetPlayerAvatar 的參數
+type avatarQuery struct {
+	UUID string `uri:"uuid" form:"-" required:"true" pattern:"^[0-9A-Fa-f-]{32,36}$" description:"玩家的 UUID，可不帶連字符"`
+	Size int    `form:"size" minimum:"8" maximum:"512" default:"64" description:"頭像的邊長（像素）"`
+	Lang string `form:"lang" maxLength:"35" description:"錯誤信息的語言，例如 en"`
+}
+
+// GetPlayerAvatar 返回玩家皮膚的頭部正面 PNG，供前端顯示在線玩家列表的頭像
+func GetPlayerAvatar(c *gin.Context) {
+	var q avatarQuery
+	if !bindRequest(c, &q) {
+		return
+	}
+	size := q.Size
+	if size == 0 {
+		size = defaultAvatarSize
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), profileTimeout)
+	defer cancel()
+	data, err := mcstatus.GetPlayerAvatar(ctx, q.UUID, size)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	c.Header("Cache-Control", avatarCacheControl)
+	c.Data(http.StatusOK, "image/png", data)
+}
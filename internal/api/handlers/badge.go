@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"backend/mcstatus"
+	"fmt"
+	"html"
+	"net"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+)
+
+// badgeCacheControl 是 SVG 徽章的緩存時間，避免嵌入的圖片過於頻繁地觸發查詢
+const badgeCacheControl = "public, max-age=60"
+
+// 徽章的顏色
+const (
+	badgeColorOnline  = "#4c1"
+	badgeColorOffline = "#e05d44"
+	badgeColorLabel   = "#555"
+)
+
+// shieldsBadge 是 shields.io endpoint 徽章的 JSON 格式
+// 參見 https://shields.io/badges/endpoint-badge
+type shieldsBadge struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Label         string `json:"label"`
+	Message       string `json:"message"`
+	Color         string `json:"color"`
+	IsError       bool   `json:"isError,omitempty"`
+}
+
+// badgeQuery 是 GetBadge 的參數，地址可帶 .svg 後綴
+type badgeQuery struct {
+	Address string `uri:"address" form:"-" required:"true" maxLength:"264" pattern:"^[^\\s\\p{C}]+$" description:"Minecraft 伺服器的地址，可帶端口"`
+	Edition string `form:"edition" enum:"java,bedrock" default:"java" description:"伺服器版本"`
+}
+
+// GetBadge 以 shields.io endpoint 徽章的格式返回伺服器的在線人數
+// 地址以 .svg 結尾時直接返回 SVG 徽章
+func GetBadge(c *gin.Context) {
+	var q badgeQuery
+	if !bindRequest(c, &q) {
+		return
+	}
+	if svgAddress, ok := strings.CutSuffix(q.Address, ".svg"); ok {
+		renderSVGBadge(c, svgAddress, q.Edition)
+		return
+	}
+
+	badge := shieldsBadge{SchemaVersion: 1, Label: "players"}
+	online, maxPlayers, err := queryPlayerCount(c, q.Address, q.Edition)
+	// 伺服器離線時仍然返回 200，否則 shields.io 只會顯示無法獲取數據
+	if err != nil {
+		badge.Message = "offline"
+		badge.Color = "red"
+		badge.IsError = true
+	} else {
+		badge.Message = fmt.Sprintf("%d/%d", online, maxPlayers)
+		badge.Color = "green"
+	}
+	c.JSON(http.StatusOK, badge)
+}
+
+// renderSVGBadge 返回以伺服器名稱為標籤、在線人數為內容的 SVG 徽章
+func renderSVGBadge(c *gin.Context, address, edition string) {
+	label := address
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		label = host
+	}
+
+	message, color := "offline", badgeColorOffline
+	if online, maxPlayers, err := queryPlayerCount(c, address, edition); err == nil {
+		message, color = fmt.Sprintf("%d/%d", online, maxPlayers), badgeColorOnline
+	}
+
+	c.Header("Cache-Control", badgeCacheControl)
+	c.Data(http.StatusOK, "image/svg+xml; charset=utf-8", []byte(badgeSVG(label, message, color)))
+}
+
+// queryPlayerCount 查詢伺服器的在線人數和最大人數
+func queryPlayerCount(c *gin.Context, address, edition string) (online, maxPlayers int, err error) {
+	if edition == mcstatus.EditionBedrock {
+		status, err := cachedBedrockStatus(c, address)
+		if err != nil {
+			return 0, 0, err
+		}
+		return status.Players.Online, status.Players.Max, nil
+	}
+	status, err := cachedJavaStatus(c, address)
+	if err != nil {
+		return 0, 0, err
+	}
+	return status.Players.Online, status.Players.Max, nil
+}
+
+// badgeSVG 生成與 shields.io flat 樣式相同的 SVG 徽章
+func badgeSVG(label, message, color string) string {
+	labelWidth := badgeTextWidth(label) + 10
+	messageWidth := badgeTextWidth(message) + 10
+	width := labelWidth + messageWidth
+	label, message = html.EscapeString(label), html.EscapeString(message)
+
+	return fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%[1]d" height="20" role="img" aria-label="%[4]s: %[5]s">`+
+		`<title>%[4]s: %[5]s</title>`+
+		`<linearGradient id="s" x2="0" y2="100%%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>`+
+		`<clipPath id="r"><rect width="%[1]d" height="20" rx="3" fill="#fff"/></clipPath>`+
+		`<g clip-path="url(#r)"><rect width="%[2]d" height="20" fill="%[7]s"/><rect x="%[2]d" width="%[3]d" height="20" fill="%[6]s"/><rect width="%[1]d" height="20" fill="url(#s)"/></g>`+
+		`<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">`+
+		`<text x="%.1[8]f" y="15" fill="#010101" fill-opacity=".3">%[4]s</text><text x="%.1[8]f" y="14">%[4]s</text>`+
+		`<text x="%.1[9]f" y="15" fill="#010101" fill-opacity=".3">%[5]s</text><text x="%.1[9]f" y="14">%[5]s</text>`+
+		`</g></svg>`,
+		width, labelWidth, messageWidth, label, message, color, badgeColorLabel,
+		float64(labelWidth)/2, float64(labelWidth)+float64(messageWidth)/2)
+}
+
+// badgeTextWidth 估算文本以 11px Verdana 字體顯示時的寬度
+func badgeTextWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		switch {
+		case r >= utf8.RuneSelf:
+			width += 11 // 全形字符
+		case strings.ContainsRune("ijlt.,:;!|' ", r):
+			width += 4
+		case strings.ContainsRune("mwMW", r):
+			width += 10
+		default:
+			width += 7
+		}
+	}
+	return width
+}
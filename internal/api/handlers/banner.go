@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"backend/mcstatus"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bannerQuery 是 GetBanner 的參數，地址帶有 .png 後綴
+type bannerQuery struct {
+	Address string `uri:"address" form:"-" required:"true" maxLength:"264" pattern:"^[^\\s\\p{C}]+$" description:"Minecraft 伺服器的地址，可帶端口"`
+	Edition string `form:"edition" enum:"java,bedrock" default:"java" description:"伺服器版本"`
+}
+
+// GetBanner 返回 468×60 的 PNG 狀態橫幅，包含伺服器圖標、MOTD、在線人數和延遲
+// 路由為 /api/banner/:address.png，伺服器離線時返回離線樣式的橫幅
+func GetBanner(c *gin.Context) {
+	var q bannerQuery
+	if !bindRequest(c, &q) {
+		return
+	}
+	address, ok := strings.CutSuffix(q.Address, ".png")
+	if !ok {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	banner := mcstatus.Banner{Title: address}
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		banner.Title = host
+	}
+
+	if q.Edition == mcstatus.EditionBedrock {
+		if status, err := cachedBedrockStatus(c, address); err == nil {
+			banner.Online = true
+			banner.MOTD = status.MOTDComponents
+			banner.Players, banner.MaxPlayers = status.Players.Online, status.Players.Max
+			banner.Latency = status.Latency
+		}
+	} else {
+		if status, err := cachedJavaStatus(c, address); err == nil {
+			banner.Online = true
+			banner.Favicon = status.Favicon
+			banner.MOTD = status.Description.Components
+			banner.Players, banner.MaxPlayers = status.Players.Online, status.Players.Max
+			banner.Latency = status.Latency
+		}
+	}
+
+	data, err := mcstatus.RenderBanner(banner)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	c.Header("Cache-Control", badgeCacheControl)
+	c.Data(http.StatusOK, "image/png", data)
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"backend/mcstatus"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// cacheEntry 是緩存條目的摘要
+type cacheEntry struct {
+	Address    string `json:"address"`
+	Edition    string `json:"edition"`
+	AgeSeconds int64  `json:"age_seconds"` // 距離實際查詢的秒數
+	Hits       int    `json:"hits"`        // 返回緩存結果的次數
+	Stale      bool   `json:"stale"`       // 是否已經過期
+}
+
+// cacheEntriesResponse 是 GetCacheEntries 的回應
+type cacheEntriesResponse struct {
+	Entries []cacheEntry `json:"entries"`
+}
+
+// cacheEvictResponse 是移除緩存條目的回應
+type cacheEvictResponse struct {
+	Evicted int `json:"evicted"` // 移除的條目數
+}
+
+// GetCacheEntries 列出狀態緩存中的所有條目
+func GetCacheEntries(c *gin.Context) {
+	infos := mcstatus.CacheEntries()
+	entries := make([]cacheEntry, len(infos))
+	for i, e := range infos {
+		entries[i] = cacheEntry{
+			Address:    e.Address,
+			Edition:    e.Edition,
+			AgeSeconds: int64(e.Age.Seconds()),
+			Hits:       e.Hits,
+			Stale:      e.Stale,
+		}
+	}
+	respondJSON(c, http.StatusOK, cacheEntriesResponse{Entries: entries})
+}
+
+// DeleteCacheEntry 移除指定地址所有版本的緩存結果，地址無效時返回 400
+func DeleteCacheEntry(c *gin.Context) {
+	n, err := mcstatus.EvictCache(c.Param("address"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	respondJSON(c, http.StatusOK, cacheEvictResponse{Evicted: n})
+}
+
+// FlushCache 清空狀態緩存
+func FlushCache(c *gin.Context) {
+	respondJSON(c, http.StatusOK, cacheEvictResponse{Evicted: mcstatus.FlushCache()})
+}
+
+// cachedStatus 通過狀態緩存查詢伺服器狀態，供徽章、橫幅和兼容 API 等經常被網頁直接引用的端點使用
+// 查詢失敗時返回緩存中最後一次成功的結果（如果有），未啟用緩存時直接查詢
+func cachedStatus(c *gin.Context, edition, address string) (*mcstatus.EditionStatus, mcstatus.CacheInfo, error) {
+	return mcstatus.GetCachedStatusContext(c.Request.Context(), edition, address, mcstatus.CacheOptions{
+		StaleIfError: true,
+		Client:       c.ClientIP(),
+	})
+}
+
+// cachedJavaStatus 通過狀態緩存查詢 Java 版伺服器狀態
+func cachedJavaStatus(c *gin.Context, address string) (*mcstatus.ServerStatus, error) {
+	result, _, err := cachedStatus(c, mcstatus.EditionJava, address)
+	if err != nil {
+		return nil, err
+	}
+	return result.Java, nil
+}
+
+// cachedBedrockStatus 通過狀態緩存查詢基岩版伺服器狀態
+func cachedBedrockStatus(c *gin.Context, address string) (*mcstatus.BedrockStatus, error) {
+	result, _, err := cachedStatus(c, mcstatus.EditionBedrock, address)
+	if err != nil {
+		return nil, err
+	}
+	return result.Bedrock, nil
+}
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"backend/mcstatus"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 比較查詢的限制
+const (
+	maxCompareServers  = 20 // 單次比較的最大伺服器數
+	compareConcurrency = 8  // 同時進行的最大查詢數
+)
+
+// compareQuery 是 CompareServers 的查詢參數
+type compareQuery struct {
+	Addresses string `form:"addresses" required:"true" description:"以逗號分隔的伺服器地址列表，最多 20 個"`
+	Edition   string `form:"edition" enum:"java,bedrock,auto" default:"java" description:"伺服器版本，auto 會依次嘗試 Java 版和基岩版"`
+	Format    string `form:"format" enum:"json,xml,msgpack" default:"json" description:"回應格式，也可以通過 Accept 標頭選擇"`
+	Lang      string `form:"lang" maxLength:"35" description:"錯誤信息的語言，例如 en"`
+}
+
+// compareResult 是單個伺服器的比較結果
+type compareResult struct {
+	Address   string    `json:"address"`
+	Online    bool      `json:"online"`
+	Edition   string    `json:"edition,omitempty"` // 實際回應的版本
+	Version   string    `json:"version,omitempty"` // 伺服器版本名稱
+	Players   int       `json:"players"`           // 在線人數
+	Max       int       `json:"max_players"`       // 最大人數
+	LatencyMS int64     `json:"latency_ms"`        // 連接延遲（毫秒），離線時為 0
+	Error     *apiError `json:"error,omitempty"`   // 查詢失敗的原因
+}
+
+// compareResponse 是 CompareServers 的回應格式
+type compareResponse struct {
+	Servers []compareResult `json:"servers"` // 按在線人數從多到少排序，離線的伺服器排在最後
+}
+
+// CompareServers 並發查詢多個伺服器，按在線人數排序返回，方便選擇要加入的伺服器
+func CompareServers(c *gin.Context) {
+	var q compareQuery
+	if !bindQuery(c, &q) {
+		return
+	}
+
+	addresses, edition, ok := parseServerList(c, q.Addresses, q.Edition)
+	if !ok {
+		return
+	}
+
+	lang := requestLanguage(c)
+	c.Header("Content-Language", lang)
+	results := queryServers(addresses, edition, lang)
+
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.Online != b.Online {
+			return a.Online
+		}
+		if a.Players != b.Players {
+			return a.Players > b.Players
+		}
+		return a.LatencyMS < b.LatencyMS
+	})
+
+	respondJSON(c, http.StatusOK, compareResponse{Servers: results})
+}
+
+// parseServerList 解析以逗號分隔的地址列表並檢查數量，缺省的伺服器版本為 Java 版，檢查失敗時返回錯誤回應
+func parseServerList(c *gin.Context, list, edition string) ([]string, string, bool) {
+	var addresses []string
+	for _, address := range strings.Split(list, ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+	if len(addresses) == 0 {
+		abortWithError(c, codeInvalidRequest, "伺服器地址不能為空")
+		return nil, "", false
+	}
+	if len(addresses) > maxCompareServers {
+		abortWithError(c, codeInvalidRequest, "單次最多查詢 %d 個伺服器", maxCompareServers)
+		return nil, "", false
+	}
+
+	if edition == "" {
+		edition = mcstatus.EditionJava
+	}
+	return addresses, edition, true
+}
+
+// queryServers 並發查詢多個伺服器，結果順序與 addresses 相同
+func queryServers(addresses []string, edition, lang string) []compareResult {
+	results := make([]compareResult, len(addresses))
+	sem := make(chan struct{}, compareConcurrency)
+	var wg sync.WaitGroup
+	for i, address := range addresses {
+		wg.Add(1)
+		go func(i int, address string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i] = compareServer(address, edition, lang)
+		}(i, address)
+	}
+	wg.Wait()
+	return results
+}
+
+// compareServer 查詢單個伺服器，失敗時在結果中記錄錯誤
+func compareServer(address, edition, lang string) compareResult {
+	result := compareResult{Address: address}
+	status, _, err := mcstatus.GetCachedStatus(edition, address, mcstatus.CacheOptions{})
+	if err != nil {
+		e := newAPIError(lang, err)
+		result.Error = &e
+		return result
+	}
+
+	result.Online = true
+	if s := status.Bedrock; s != nil {
+		result.Edition = mcstatus.EditionBedrock
+		result.Version = s.Version
+		result.Players, result.Max = s.Players.Online, s.Players.Max
+		result.LatencyMS = s.Latency.Milliseconds()
+	} else {
+		s := status.Java
+		result.Edition = mcstatus.EditionJava
+		result.Version = s.Version.Name
+		result.Players, result.Max = s.Players.Online, s.Players.Max
+		result.LatencyMS = s.Latency.Milliseconds()
+	}
+	return result
+}
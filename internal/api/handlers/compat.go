@@ -0,0 +1,392 @@
+package handlers
+
+import (
+	"backend/mcstatus"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// mcsrvstatDebug 是 mcsrvstat.us 回應中的 debug 字段
+type mcsrvstatDebug struct {
+	Ping          bool `json:"ping"`
+	Query         bool `json:"query"`
+	SRV           bool `json:"srv"`
+	QueryMismatch bool `json:"querymismatch"`
+	IPInSRV       bool `json:"ipinsrv"`
+	CNAMEInSRV    bool `json:"cnameinsrv"`
+	AnimatedMOTD  bool `json:"animatedmotd"`
+	CacheTime     int  `json:"cachetime"`
+	APIVersion    int  `json:"apiversion"`
+}
+
+// mcsrvstatMOTD 是按行分割的 MOTD
+type mcsrvstatMOTD struct {
+	Raw   []string `json:"raw"`
+	Clean []string `json:"clean"`
+	HTML  []string `json:"html"`
+}
+
+// mcsrvstatPlayers 是玩家信息，uuid 是玩家名稱到 UUID 的對應表
+type mcsrvstatPlayers struct {
+	Online int               `json:"online"`
+	Max    int               `json:"max"`
+	List   []string          `json:"list,omitempty"`
+	UUID   map[string]string `json:"uuid,omitempty"`
+}
+
+// mcsrvstatMods 是 Forge 模組信息，raw 是模組 ID 到版本的對應表
+type mcsrvstatMods struct {
+	Names []string          `json:"names"`
+	Raw   map[string]string `json:"raw"`
+}
+
+// mcsrvstatStatus 是與 mcsrvstat.us v2 API 相同格式的伺服器狀態
+type mcsrvstatStatus struct {
+	IP       string            `json:"ip"`
+	Port     int               `json:"port"`
+	Debug    mcsrvstatDebug    `json:"debug"`
+	MOTD     *mcsrvstatMOTD    `json:"motd,omitempty"`
+	Players  *mcsrvstatPlayers `json:"players,omitempty"`
+	Version  string            `json:"version,omitempty"`
+	Online   bool              `json:"online"`
+	Protocol int               `json:"protocol,omitempty"`
+	Hostname string            `json:"hostname,omitempty"`
+	Icon     string            `json:"icon,omitempty"`
+	Software string            `json:"software,omitempty"`
+	Mods     *mcsrvstatMods    `json:"mods,omitempty"`
+	Map      string            `json:"map,omitempty"`
+	GameMode string            `json:"gamemode,omitempty"`
+	ServerID string            `json:"serverid,omitempty"`
+}
+
+// compatQuery 是兼容 API 的參數
+type compatQuery struct {
+	Address string `uri:"address" form:"-" required:"true" maxLength:"260" pattern:"^[^\\s\\p{C}]+$" description:"Minecraft 伺服器的地址，可帶端口"`
+}
+
+// GetMcsrvstatJava 以 mcsrvstat.us v2 API 的格式返回 Java 版伺服器狀態
+func GetMcsrvstatJava(c *gin.Context) {
+	var q compatQuery
+	if !bindRequest(c, &q) {
+		return
+	}
+	address := q.Address
+	result := newMcsrvstatStatus(address)
+
+	status, err := cachedJavaStatus(c, address)
+	if err != nil {
+		// 與 mcsrvstat.us 相同，伺服器離線時仍然返回 200
+		c.JSON(http.StatusOK, result)
+		return
+	}
+
+	result.IP = status.IP
+	result.Port = status.Port
+	result.Debug.SRV = status.SRVTarget != ""
+	result.Online = true
+	result.MOTD = mcsrvstatLines(status.Description.Components)
+	result.Version = status.Version.Name
+	result.Protocol = status.Version.Protocol
+	result.Icon = status.Favicon
+	result.Players = &mcsrvstatPlayers{Online: status.Players.Online, Max: status.Players.Max}
+	if len(status.Players.Sample) > 0 {
+		result.Players.UUID = make(map[string]string, len(status.Players.Sample))
+		for _, p := range status.Players.Sample {
+			result.Players.List = append(result.Players.List, p.Name)
+			result.Players.UUID[p.Name] = p.ID
+		}
+	}
+	if status.Software != nil && status.Software.Name != "Unknown" {
+		result.Software = status.Software.Name
+	}
+	if status.Forge != nil {
+		result.Mods = &mcsrvstatMods{Names: []string{}, Raw: make(map[string]string, len(status.Forge.Mods))}
+		for _, m := range status.Forge.Mods {
+			result.Mods.Names = append(result.Mods.Names, m.ID)
+			result.Mods.Raw[m.ID] = m.Version
+		}
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetMcsrvstatBedrock 以 mcsrvstat.us v2 API 的格式返回基岩版伺服器狀態
+func GetMcsrvstatBedrock(c *gin.Context) {
+	var q compatQuery
+	if !bindRequest(c, &q) {
+		return
+	}
+	address := q.Address
+	result := newMcsrvstatStatus(address)
+
+	status, err := cachedBedrockStatus(c, address)
+	if err != nil {
+		c.JSON(http.StatusOK, result)
+		return
+	}
+
+	result.IP = status.IP
+	result.Port = status.Port
+	result.Online = true
+	result.MOTD = mcsrvstatLines(status.MOTDComponents)
+	result.Version = status.Version
+	result.Protocol = status.Protocol
+	result.Players = &mcsrvstatPlayers{Online: status.Players.Online, Max: status.Players.Max}
+	result.Map = status.MapName
+	result.GameMode = status.GameMode
+	result.ServerID = status.ServerID
+
+	c.JSON(http.StatusOK, result)
+}
+
+// newMcsrvstatStatus 創建離線伺服器的回應，查詢成功後再填入其餘字段
+func newMcsrvstatStatus(address string) *mcsrvstatStatus {
+	hostname := address
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		hostname = host
+	}
+	return &mcsrvstatStatus{
+		Hostname: hostname,
+		Debug:    mcsrvstatDebug{Ping: true, APIVersion: 2},
+	}
+}
+
+// mcsrvstatLines 將 MOTD 按行渲染為舊版格式代碼、純文本和 HTML
+func mcsrvstatLines(components []mcstatus.MOTDComponent) *mcsrvstatMOTD {
+	motd := &mcsrvstatMOTD{Raw: []string{}, Clean: []string{}, HTML: []string{}}
+	for _, line := range mcstatus.SplitLines(components) {
+		motd.Raw = append(motd.Raw, mcstatus.RenderLegacy(line))
+		motd.Clean = append(motd.Clean, mcstatus.RenderPlainText(line))
+		motd.HTML = append(motd.HTML, mcstatus.RenderHTML(line))
+	}
+	return motd
+}
+
+// mcstatusioText 是同時提供原始格式代碼、純文本和 HTML 的文本
+type mcstatusioText struct {
+	Raw   string `json:"raw"`
+	Clean string `json:"clean"`
+	HTML  string `json:"html"`
+}
+
+// mcstatusioSRV 是 SRV 記錄指向的地址
+type mcstatusioSRV struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+}
+
+// mcstatusioJavaVersion 是 Java 版伺服器的版本信息
+type mcstatusioJavaVersion struct {
+	NameRaw   string `json:"name_raw"`
+	NameClean string `json:"name_clean"`
+	NameHTML  string `json:"name_html"`
+	Protocol  int    `json:"protocol"`
+}
+
+// mcstatusioBedrockVersion 是基岩版伺服器的版本信息
+type mcstatusioBedrockVersion struct {
+	Name     string `json:"name"`
+	Protocol int    `json:"protocol"`
+}
+
+// mcstatusioBedrockPlayers 是基岩版伺服器的玩家數量
+type mcstatusioBedrockPlayers struct {
+	Online int `json:"online"`
+	Max    int `json:"max"`
+}
+
+// mcstatusioPlayer 是玩家列表中的玩家
+type mcstatusioPlayer struct {
+	UUID      string `json:"uuid"`
+	NameRaw   string `json:"name_raw"`
+	NameClean string `json:"name_clean"`
+	NameHTML  string `json:"name_html"`
+}
+
+// mcstatusioPlayers 是 Java 版伺服器的玩家信息
+type mcstatusioPlayers struct {
+	Online int                `json:"online"`
+	Max    int                `json:"max"`
+	List   []mcstatusioPlayer `json:"list"`
+}
+
+// mcstatusioMod 是模組或插件的名稱和版本
+type mcstatusioMod struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// mcstatusioStatus 是 mcstatus.io v2 API 中兩個版本共有的字段
+type mcstatusioStatus struct {
+	Online      bool    `json:"online"`
+	Host        string  `json:"host"`
+	Port        int     `json:"port"`
+	IPAddress   *string `json:"ip_address"`
+	EULABlocked bool    `json:"eula_blocked"`
+	RetrievedAt int64   `json:"retrieved_at"`
+	ExpiresAt   int64   `json:"expires_at"`
+}
+
+// mcstatusioJava 是與 mcstatus.io v2 API 相同格式的 Java 版伺服器狀態
+type mcstatusioJava struct {
+	mcstatusioStatus
+	SRVRecord *mcstatusioSRV `json:"srv_record"`
+	*mcstatusioJavaOnline
+}
+
+// mcstatusioJavaOnline 是 Java 版伺服器在線時才返回的字段
+type mcstatusioJavaOnline struct {
+	Version  mcstatusioJavaVersion `json:"version"`
+	Players  mcstatusioPlayers     `json:"players"`
+	MOTD     mcstatusioText        `json:"motd"`
+	Icon     *string               `json:"icon"`
+	Mods     []mcstatusioMod       `json:"mods"`
+	Software *string               `json:"software"`
+	Plugins  []mcstatusioMod       `json:"plugins"`
+}
+
+// mcstatusioBedrock 是與 mcstatus.io v2 API 相同格式的基岩版伺服器狀態
+type mcstatusioBedrock struct {
+	mcstatusioStatus
+	*mcstatusioBedrockOnline
+}
+
+// mcstatusioBedrockOnline 是基岩版伺服器在線時才返回的字段
+type mcstatusioBedrockOnline struct {
+	Version  mcstatusioBedrockVersion `json:"version"`
+	Players  mcstatusioBedrockPlayers `json:"players"`
+	MOTD     mcstatusioText           `json:"motd"`
+	GameMode string                   `json:"gamemode"`
+	ServerID string                   `json:"server_id"`
+	Edition  string                   `json:"edition"`
+}
+
+// GetMcstatusioJava 以 mcstatus.io v2 API 的格式返回 Java 版伺服器狀態
+func GetMcstatusioJava(c *gin.Context) {
+	var q compatQuery
+	if !bindRequest(c, &q) {
+		return
+	}
+	address := q.Address
+	result := &mcstatusioJava{mcstatusioStatus: newMcstatusioStatus(address, 25565)}
+
+	cached, cache, err := cachedStatus(c, mcstatus.EditionJava, address)
+	if err != nil {
+		// 與 mcstatus.io 相同，伺服器離線時仍然返回 200
+		c.JSON(http.StatusOK, result)
+		return
+	}
+	status := cached.Java
+
+	result.Online = true
+	result.setRetrieved(cache)
+	result.IPAddress = &status.IP
+	if status.SRVTarget != "" {
+		result.SRVRecord = &mcstatusioSRV{Host: status.SRVTarget, Port: status.Port}
+	}
+
+	version := mcstatusioFormat(mcstatus.ParseLegacyText(status.Version.Name))
+	online := &mcstatusioJavaOnline{
+		Version: mcstatusioJavaVersion{
+			NameRaw:   version.Raw,
+			NameClean: version.Clean,
+			NameHTML:  version.HTML,
+			Protocol:  status.Version.Protocol,
+		},
+		Players: mcstatusioPlayers{Online: status.Players.Online, Max: status.Players.Max, List: []mcstatusioPlayer{}},
+		MOTD:    mcstatusioFormat(status.Description.Components),
+		Mods:    []mcstatusioMod{},
+		// 插件列表需要 Query 協議，本服務不支援
+		Plugins: []mcstatusioMod{},
+	}
+	for _, p := range status.Players.Sample {
+		name := mcstatusioFormat(mcstatus.ParseLegacyText(p.Name))
+		online.Players.List = append(online.Players.List, mcstatusioPlayer{
+			UUID:      p.ID,
+			NameRaw:   name.Raw,
+			NameClean: name.Clean,
+			NameHTML:  name.HTML,
+		})
+	}
+	if status.Favicon != "" {
+		online.Icon = &status.Favicon
+	}
+	if status.Forge != nil {
+		for _, m := range status.Forge.Mods {
+			online.Mods = append(online.Mods, mcstatusioMod{Name: m.ID, Version: m.Version})
+		}
+	}
+	if status.Software != nil && status.Software.Name != "Unknown" {
+		online.Software = &status.Software.Name
+	}
+	result.mcstatusioJavaOnline = online
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetMcstatusioBedrock 以 mcstatus.io v2 API 的格式返回基岩版伺服器狀態
+func GetMcstatusioBedrock(c *gin.Context) {
+	var q compatQuery
+	if !bindRequest(c, &q) {
+		return
+	}
+	address := q.Address
+	result := &mcstatusioBedrock{mcstatusioStatus: newMcstatusioStatus(address, 19132)}
+
+	cached, cache, err := cachedStatus(c, mcstatus.EditionBedrock, address)
+	if err != nil {
+		c.JSON(http.StatusOK, result)
+		return
+	}
+	status := cached.Bedrock
+
+	result.Online = true
+	result.setRetrieved(cache)
+	result.IPAddress = &status.IP
+	result.mcstatusioBedrockOnline = &mcstatusioBedrockOnline{
+		Version:  mcstatusioBedrockVersion{Name: status.Version, Protocol: status.Protocol},
+		Players:  mcstatusioBedrockPlayers{Online: status.Players.Online, Max: status.Players.Max},
+		MOTD:     mcstatusioFormat(status.MOTDComponents),
+		GameMode: status.GameMode,
+		ServerID: status.ServerID,
+		Edition:  status.GameType,
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// newMcstatusioStatus 創建離線伺服器的回應，查詢成功後再填入其餘字段
+// 查詢失敗的結果不會被緩存，因此 expires_at 與 retrieved_at 相同
+func newMcstatusioStatus(address string, defaultPort int) mcstatusioStatus {
+	status := mcstatusioStatus{Host: address, Port: defaultPort}
+	if host, port, err := net.SplitHostPort(address); err == nil {
+		status.Host = host
+		if p, err := strconv.Atoi(port); err == nil {
+			status.Port = p
+		}
+	}
+	status.RetrievedAt = time.Now().UnixMilli()
+	status.ExpiresAt = status.RetrievedAt
+	return status
+}
+
+// setRetrieved 根據緩存條目的實際查詢時間設置 retrieved_at，expires_at 為該時間加上緩存時間
+// 未啟用狀態緩存時兩者相同
+func (s *mcstatusioStatus) setRetrieved(cache mcstatus.CacheInfo) {
+	retrieved := time.Now().Add(-cache.Age)
+	s.RetrievedAt = retrieved.UnixMilli()
+	s.ExpiresAt = retrieved.Add(cache.TTL).UnixMilli()
+}
+
+// mcstatusioFormat 將文本片段渲染為舊版格式代碼、純文本和 HTML
+func mcstatusioFormat(components []mcstatus.MOTDComponent) mcstatusioText {
+	return mcstatusioText{
+		Raw:   mcstatus.RenderLegacy(components),
+		Clean: mcstatus.RenderPlainText(components),
+		HTML:  mcstatus.RenderHTML(components),
+	}
+}
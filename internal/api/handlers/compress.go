@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// compressMinSize 是壓縮回應的最小長度，更短的回應壓縮後節省的流量不值得額外的開銷
+const compressMinSize = 1024
+
+var gzipWriters = sync.Pool{
+	New: func() any { return gzip.NewWriter(nil) },
+}
+
+// Compress 以 gzip 壓縮文本類型（JSON、XML、SVG 等）的回應
+// 包含 base64 圖標的狀態回應通常有 10–30 KB，壓縮後只有幾 KB，對頻繁輪詢的移動端前端影響很大
+// 回應在達到 compressMinSize 之前先緩衝，以便較短的回應保持不壓縮
+func Compress() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodHead {
+			return
+		}
+		w := &compressWriter{ResponseWriter: c.Writer, acceptsGzip: acceptsGzip(c.Request)}
+		c.Writer = w
+		defer w.finish()
+		c.Next()
+	}
+}
+
+// acceptsGzip 檢查客戶端是否接受 gzip 編碼
+func acceptsGzip(r *http.Request) bool {
+	for _, part := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if strings.EqualFold(strings.TrimSpace(coding), "gzip") {
+			return strings.ReplaceAll(params, " ", "") != "q=0"
+		}
+	}
+	return false
+}
+
+// compressible 檢查內容類型是否值得壓縮，圖片和 MessagePack 等二進制格式不壓縮
+func compressible(contentType string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	return strings.HasPrefix(mediaType, "text/") ||
+		strings.Contains(mediaType, "json") ||
+		strings.Contains(mediaType, "xml") ||
+		strings.Contains(mediaType, "javascript")
+}
+
+// compressWriter 緩衝回應的開頭，根據內容類型和長度決定是否壓縮
+type compressWriter struct {
+	gin.ResponseWriter
+	acceptsGzip bool
+	buf         bytes.Buffer
+	decided     bool
+	gz          *gzip.Writer
+}
+
+// Write 寫入回應內容
+func (w *compressWriter) Write(data []byte) (int, error) {
+	if w.decided {
+		return w.write(data)
+	}
+	w.buf.Write(data)
+	if w.buf.Len() >= compressMinSize {
+		if err := w.decide(); err != nil {
+			return 0, err
+		}
+	}
+	return len(data), nil
+}
+
+// WriteString 寫入字符串形式的回應內容
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Flush 立即發送已緩衝的內容
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		w.decide()
+	}
+	if w.gz != nil {
+		w.gz.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// Written 返回回應是否已經開始寫入，包括仍在緩衝中的內容
+func (w *compressWriter) Written() bool {
+	return w.buf.Len() > 0 || w.ResponseWriter.Written()
+}
+
+// decide 根據已緩衝的內容決定是否壓縮，並寫出緩衝的內容
+func (w *compressWriter) decide() error {
+	w.decided = true
+	header := w.Header()
+	status := w.Status()
+	if compressible(header.Get("Content-Type")) && header.Get("Content-Encoding") == "" &&
+		status != http.StatusNoContent && status != http.StatusNotModified {
+		header.Add("Vary", "Accept-Encoding")
+		if w.acceptsGzip && w.buf.Len() >= compressMinSize {
+			header.Set("Content-Encoding", "gzip")
+			header.Del("Content-Length")
+			w.gz = gzipWriters.Get().(*gzip.Writer)
+			w.gz.Reset(w.ResponseWriter)
+		}
+	}
+	data := w.buf.Bytes()
+	w.buf = bytes.Buffer{}
+	if len(data) == 0 {
+		return nil
+	}
+	_, err := w.write(data)
+	return err
+}
+
+// write 將內容寫入客戶端，需要時經過壓縮
+func (w *compressWriter) write(data []byte) (int, error) {
+	if w.gz != nil {
+		return w.gz.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// finish 在請求處理完畢後寫出剩餘的緩衝內容並結束壓縮
+func (w *compressWriter) finish() {
+	if !w.decided {
+		w.decide()
+	}
+	if w.gz != nil {
+		w.gz.Close()
+		w.gz.Reset(nil)
+		gzipWriters.Put(w.gz)
+		w.gz = nil
+	}
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"backend/mcstatus"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// crossplayQuery 是 GetCrossplayStatus 的查詢參數
+type crossplayQuery struct {
+	Address     string `form:"address" required:"true" maxLength:"260" description:"Minecraft 伺服器的地址，端口部分用於 Java 版查詢"`
+	BedrockPort string `form:"bedrock_port" pattern:"^[0-9]+$" default:"19132" description:"基岩版端口"`
+	Format      string `form:"format" enum:"json,xml,msgpack" default:"json" description:"回應格式，也可以通過 Accept 標頭選擇"`
+	Lang        string `form:"lang" maxLength:"35" description:"錯誤信息的語言，例如 en"`
+}
+
+// crossplayResponse 是 GetCrossplayStatus 的回應格式，查詢失敗的原因與 compare 一樣包含錯誤類別，並使用請求的語言
+type crossplayResponse struct {
+	*mcstatus.CrossplayStatus
+	JavaError    *apiError `json:"java_error,omitempty"`    // Java 版查詢失敗的原因
+	BedrockError *apiError `json:"bedrock_error,omitempty"` // 基岩版查詢失敗的原因
+}
+
+// GetCrossplayStatus 同時查詢 Java 版和基岩版端口，返回跨平台支援情況及兩者的狀態
+func GetCrossplayStatus(c *gin.Context) {
+	var q crossplayQuery
+	if !bindQuery(c, &q) {
+		return
+	}
+
+	if q.BedrockPort != "" {
+		if _, err := strconv.ParseUint(q.BedrockPort, 10, 16); err != nil {
+			abortWithError(c, codeInvalidRequest, "無效的基岩版端口: %s", q.BedrockPort)
+			return
+		}
+	}
+
+	lang := requestLanguage(c)
+	c.Header("Content-Language", lang)
+	result := mcstatus.GetCrossplayStatusContext(c.Request.Context(), q.Address, q.BedrockPort, mcstatus.QueryOptions{Client: c.ClientIP()})
+	response := crossplayResponse{CrossplayStatus: result}
+	if result.JavaErr != nil {
+		e := newAPIError(lang, result.JavaErr)
+		response.JavaError = &e
+	}
+	if result.BedrockErr != nil {
+		e := newAPIError(lang, result.BedrockErr)
+		response.BedrockError = &e
+	}
+	respondJSON(c, http.StatusOK, response)
+}
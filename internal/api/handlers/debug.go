@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"backend/mcstatus"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// startTime 是服務啟動的時間
+var startTime = time.Now()
+
+// memoryStats 是 Go 運行時的內存統計摘要
+type memoryStats struct {
+	AllocBytes   uint64  `json:"alloc_bytes"`       // 堆上仍在使用的字節數
+	SysBytes     uint64  `json:"sys_bytes"`         // 從操作系統獲取的字節數
+	HeapObjects  uint64  `json:"heap_objects"`      // 堆上的對象數
+	NumGC        uint32  `json:"num_gc"`            // 完成的垃圾回收次數
+	GCPauseTotal float64 `json:"gc_pause_total_ms"` // 垃圾回收暫停的總時間（毫秒）
+}
+
+// debugStats 是 GetDebugStats 的回應
+type debugStats struct {
+	UptimeSeconds   int64               `json:"uptime_seconds"`
+	Goroutines      int                 `json:"goroutines"`
+	InflightQueries int                 `json:"inflight_queries"` // 正在進行的出站查詢數
+	Memory          memoryStats         `json:"memory"`
+	Caches          mcstatus.CacheSizes `json:"caches"` // 各個內部緩存的條目數
+}
+
+// GetDebugStats 返回 goroutine 數量、內存使用和各個緩存的大小，用於排查線上問題
+func GetDebugStats(c *gin.Context) {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	respondJSON(c, http.StatusOK, debugStats{
+		UptimeSeconds:   int64(time.Since(startTime).Seconds()),
+		Goroutines:      runtime.NumGoroutine(),
+		InflightQueries: len(mcstatus.InflightQueries()),
+		Memory: memoryStats{
+			AllocBytes:   m.Alloc,
+			SysBytes:     m.Sys,
+			HeapObjects:  m.HeapObjects,
+			NumGC:        m.NumGC,
+			GCPauseTotal: float64(m.PauseTotalNs) / 1e6,
+		},
+		Caches: mcstatus.GetCacheSizes(),
+	})
+}
+
+// GetProfile 提供 net/http/pprof 的性能分析數據，子路徑與標準的 /debug/pprof/ 相同
+// 例如 heap、goroutine?debug=2、profile?seconds=30，空路徑返回索引頁面
+func GetProfile(c *gin.Context) {
+	switch name := strings.TrimPrefix(c.Param("name"), "/"); name {
+	case "":
+		pprof.Index(c.Writer, c.Request)
+	case "cmdline":
+		pprof.Cmdline(c.Writer, c.Request)
+	case "profile":
+		pprof.Profile(c.Writer, c.Request)
+	case "symbol":
+		pprof.Symbol(c.Writer, c.Request)
+	case "trace":
+		pprof.Trace(c.Writer, c.Request)
+	default:
+		pprof.Handler(name).ServeHTTP(c.Writer, c.Request)
+	}
+}
@@ -0,0 +1,210 @@
+package handlers
+
+import (
+	"backend/internal/api/openapi"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Endpoint 描述一個 API 端點，路由和 OpenAPI 文檔都根據它生成
+type Endpoint struct {
+	Path        string          // 相對於 API 前綴的路徑
+	Handler     gin.HandlerFunc // 處理器
+	Summary     string          // 簡短說明
+	Description string          // 詳細說明
+	Query       any             // 查詢參數結構體
+	Responses   []any           // 可能的成功回應類型，多於一種時以 oneOf 表示
+	ContentType string          // 非 JSON 回應的內容類型，為空時表示 JSON
+}
+
+// Endpoints 是所有 API 端點
+var Endpoints = []Endpoint{
+	{
+		Path:        "/server-status",
+		Handler:     GetServerStatus,
+		Summary:     "查詢伺服器狀態",
+		Description: "查詢 Minecraft Java 版或基岩版伺服器的狀態。指定 motd 參數時 description 為渲染後的字符串。",
+		Query:       serverStatusQuery{},
+		Responses:   []any{javaStatus{}, renderedStatus{}, bedrockStatus{}},
+	},
+	{
+		Path:        "/motd/html",
+		Handler:     GetMOTDHTML,
+		Summary:     "獲取 MOTD 的 HTML",
+		Description: "返回伺服器 MOTD 渲染後的 HTML 片段，可直接嵌入網頁。",
+		Query:       addressQuery{},
+		ContentType: "text/html",
+	},
+	{
+		Path:        "/server-icon",
+		Handler:     GetServerIcon,
+		Summary:     "獲取伺服器圖標",
+		Description: "以圖片的形式返回伺服器圖標，支援縮放和格式轉換。",
+		Query:       serverIconQuery{},
+		ContentType: "image/*",
+	},
+	{
+		Path:        "/crossplay",
+		Handler:     GetCrossplayStatus,
+		Summary:     "檢測跨平台支援",
+		Description: "同時查詢 Java 版和基岩版端口，返回跨平台支援情況及兩者的狀態。",
+		Query:       crossplayQuery{},
+		Responses:   []any{crossplayResponse{}},
+	},
+	{
+		Path:        "/compare",
+		Handler:     CompareServers,
+		Summary:     "比較多個伺服器",
+		Description: "並發查詢多個伺服器，按在線人數從多到少排序返回，並附帶連接延遲。",
+		Query:       compareQuery{},
+		Responses:   []any{compareResponse{}},
+	},
+	{
+		Path:        "/regions",
+		Handler:     GetRegionalStatus,
+		Summary:     "從多個區域查詢伺服器狀態",
+		Description: "同時從本實例和 PROBE_REGIONS 設定的其他區域實例查詢伺服器狀態，返回各區域的延遲，只有多數區域都認為伺服器離線時才判定為離線。",
+		Query:       regionsQuery{},
+		Responses:   []any{regionsResponse{}},
+	},
+	{
+		Path:        "/influx",
+		Handler:     GetInfluxMetrics,
+		Summary:     "以 InfluxDB line protocol 格式返回狀態",
+		Description: "並發查詢多個伺服器，以 InfluxDB line protocol 格式返回在線狀態、人數和延遲，可配合 Telegraf 採集。",
+		Query:       influxQuery{},
+		ContentType: "text/plain",
+	},
+}
+
+// apiPrefix 是 OpenAPI 文檔中使用的 API 前綴
+const apiPrefix = "/api/v1"
+
+var (
+	specOnce sync.Once
+	spec     *openapi.Document
+)
+
+// GetOpenAPISpec 返回 OpenAPI 文檔
+func GetOpenAPISpec(c *gin.Context) {
+	specOnce.Do(func() {
+		spec = buildOpenAPISpec()
+	})
+	c.JSON(http.StatusOK, spec)
+}
+
+// GetAPIDocs 返回載入 OpenAPI 文檔的 Swagger UI 頁面
+func GetAPIDocs(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(swaggerUIPage))
+}
+
+// buildOpenAPISpec 根據 Endpoints 生成 OpenAPI 文檔
+func buildOpenAPISpec() *openapi.Document {
+	g := openapi.NewGenerator()
+	errorSchema := g.Define("ErrorResponse", envelopeSchema(g, &openapi.Schema{Nullable: true}))
+
+	// 錯誤回應的狀態碼按數值排序，保證生成的文檔穩定
+	var statuses []int
+	seen := make(map[int]bool)
+	for _, status := range errorStatus {
+		if !seen[status] {
+			seen[status] = true
+			statuses = append(statuses, status)
+		}
+	}
+	sort.Ints(statuses)
+
+	doc := &openapi.Document{
+		OpenAPI: openapi.Version,
+		Info: openapi.Info{
+			Title:       "Minecraft 伺服器狀態查詢 API",
+			Description: "JSON 回應統一包裝為 data、error、meta 三個字段。",
+			Version:     "1.0.0",
+		},
+		Paths: make(map[string]openapi.PathItem),
+	}
+	for _, e := range Endpoints {
+		op := &openapi.Operation{
+			OperationID: operationID(e.Path),
+			Summary:     e.Summary,
+			Description: e.Description,
+			Parameters:  g.QueryParameters(e.Query),
+			Responses:   make(map[string]openapi.Response),
+		}
+
+		ok := openapi.Response{Description: "成功"}
+		switch {
+		case e.ContentType != "":
+			ok.Content = map[string]openapi.MediaType{
+				e.ContentType: {Schema: &openapi.Schema{Type: "string", Format: "binary"}},
+			}
+		case len(e.Responses) == 1:
+			ok.Content = jsonContent(envelopeSchema(g, g.SchemaOf(e.Responses[0])))
+		default:
+			data := &openapi.Schema{}
+			for _, r := range e.Responses {
+				data.OneOf = append(data.OneOf, g.SchemaOf(r))
+			}
+			ok.Content = jsonContent(envelopeSchema(g, data))
+		}
+		op.Responses["200"] = ok
+
+		for _, status := range statuses {
+			op.Responses[strconv.Itoa(status)] = openapi.Response{
+				Description: http.StatusText(status),
+				Content:     jsonContent(errorSchema),
+			}
+		}
+		doc.Paths[apiPrefix+e.Path] = openapi.PathItem{Get: op}
+	}
+	doc.Components.Schemas = g.Schemas
+	return doc
+}
+
+// envelopeSchema 返回以 data 為內容的統一回應格式的 Schema
+// error 和 meta 的結構在所有回應中相同，放入共用結構中引用
+func envelopeSchema(g *openapi.Generator, data *openapi.Schema) *openapi.Schema {
+	s := g.SchemaOf(envelope{})
+	s.Properties["data"] = data
+	s.Properties["error"] = g.Define("Error", s.Properties["error"])
+	s.Properties["meta"] = g.Define("Meta", s.Properties["meta"])
+	return s
+}
+
+// jsonContent 返回 JSON 內容類型的回應內容
+func jsonContent(s *openapi.Schema) map[string]openapi.MediaType {
+	return map[string]openapi.MediaType{"application/json": {Schema: s}}
+}
+
+// operationID 根據路徑生成操作 ID，例如 /server-icon 對應 getServerIcon
+func operationID(path string) string {
+	var b strings.Builder
+	b.WriteString("get")
+	for _, part := range strings.FieldsFunc(path, func(r rune) bool { return r == '/' || r == '-' }) {
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+// swaggerUIPage 是從 CDN 載入 Swagger UI 的頁面
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="zh-Hant">
+<head>
+  <meta charset="utf-8">
+  <title>Minecraft 伺服器狀態查詢 API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script>
+    window.ui = SwaggerUIBundle({ url: "/api/openapi.json", dom_id: "#swagger-ui" });
+  </script>
+</body>
+</html>
+`
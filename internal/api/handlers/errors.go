@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"backend/internal/i18n"
+	"backend/mcstatus"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// apiError 是錯誤回應的內容
+type apiError struct {
+	Code    mcstatus.ErrorCode   `json:"code"`             // 錯誤類別
+	Message string               `json:"message"`          // 錯誤信息
+	Fields  []apiFieldError      `json:"fields,omitempty"` // 驗證失敗的查詢參數（僅在參數驗證失敗時提供）
+	Debug   *mcstatus.DebugTrace `json:"debug,omitempty"`  // 調試信息（僅在調試模式下提供）
+}
+
+// apiFieldError 是單個查詢參數的驗證錯誤
+type apiFieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// 處理器層面的錯誤類別
+const (
+	codeInvalidRequest  mcstatus.ErrorCode = "INVALID_REQUEST"  // 請求參數錯誤
+	codeForbidden       mcstatus.ErrorCode = "FORBIDDEN"        // 需要管理員權限
+	codeFeatureDisabled mcstatus.ErrorCode = "FEATURE_DISABLED" // 功能已通過功能開關停用
+	codeInternalError   mcstatus.ErrorCode = "INTERNAL_ERROR"   // 未分類的內部錯誤
+
+	codeRegionUnavailable mcstatus.ErrorCode = "REGION_UNAVAILABLE" // 無法從其他區域的實例獲取結果
+)
+
+// errorStatus 是錯誤類別到 HTTP 狀態碼的對應表
+var errorStatus = map[mcstatus.ErrorCode]int{
+	codeInvalidRequest:              http.StatusBadRequest,
+	codeForbidden:                   http.StatusForbidden,
+	codeFeatureDisabled:             http.StatusForbidden,
+	codeInternalError:               http.StatusInternalServerError,
+	codeRegionUnavailable:           http.StatusBadGateway,
+	mcstatus.CodeInvalidAddress:     http.StatusBadRequest,
+	mcstatus.CodeInvalidBindAddress: http.StatusBadRequest,
+	mcstatus.CodeDNSFailure:         http.StatusNotFound,
+	mcstatus.CodeConnectTimeout:     http.StatusGatewayTimeout,
+	mcstatus.CodeConnectionRefused:  http.StatusBadGateway,
+	mcstatus.CodeConnectionFailed:   http.StatusBadGateway,
+	mcstatus.CodeReadTimeout:        http.StatusGatewayTimeout,
+	mcstatus.CodeInvalidPacket:      http.StatusBadGateway,
+	mcstatus.CodeParseError:         http.StatusBadGateway,
+	mcstatus.CodeNoFavicon:          http.StatusNotFound,
+	mcstatus.CodeInvalidFavicon:     http.StatusBadGateway,
+	mcstatus.CodePlayerNotFound:     http.StatusNotFound,
+	mcstatus.CodeProfileUnavailable: http.StatusBadGateway,
+	mcstatus.CodeBedrockDisabled:    http.StatusForbidden,
+	mcstatus.CodeHostBusy:           http.StatusServiceUnavailable,
+}
+
+// errorResponse 根據錯誤類別返回 HTTP 狀態碼和回應內容，錯誤信息使用請求的語言
+func errorResponse(c *gin.Context, err error) (int, apiError) {
+	lang := requestLanguage(c)
+	c.Header("Content-Language", lang)
+	e := newAPIError(lang, err)
+	status, ok := errorStatus[e.Code]
+	if !ok {
+		status = http.StatusInternalServerError
+	}
+	return status, e
+}
+
+// newAPIError 將查詢錯誤轉換為指定語言的錯誤內容
+func newAPIError(lang string, err error) apiError {
+	code := mcstatus.ErrorCodeOf(err)
+	if code == "" {
+		code = codeInternalError
+	}
+	return apiError{Code: code, Message: localizeError(lang, err)}
+}
+
+// respondError 根據錯誤類別返回錯誤回應
+func respondError(c *gin.Context, err error) {
+	status, body := errorResponse(c, err)
+	sendError(c, status, body)
+}
+
+// abortWithError 返回指定類別的錯誤回應，format 是中文原文，翻譯後再代入參數
+func abortWithError(c *gin.Context, code mcstatus.ErrorCode, format string, args ...any) {
+	status, ok := errorStatus[code]
+	if !ok {
+		status = http.StatusInternalServerError
+	}
+	lang := requestLanguage(c)
+	c.Header("Content-Language", lang)
+	sendError(c, status, apiError{Code: code, Message: fmt.Sprintf(i18n.Translate(lang, format), args...)})
+}
+
+// abortWithFieldErrors 返回查詢參數驗證失敗的錯誤回應，fields 中列出每個不符合的參數
+func abortWithFieldErrors(c *gin.Context, errs fieldErrors) {
+	lang := requestLanguage(c)
+	c.Header("Content-Language", lang)
+	fields := make([]apiFieldError, len(errs))
+	msgs := make([]string, len(errs))
+	for i, e := range errs {
+		fields[i] = apiFieldError{Field: e.field, Message: fmt.Sprintf(i18n.Translate(lang, e.format), e.args...)}
+		msgs[i] = e.field + " " + fields[i].Message
+	}
+	message := fmt.Sprintf(i18n.Translate(lang, "無效的請求參數: %v"), strings.Join(msgs, "; "))
+	sendError(c, errorStatus[codeInvalidRequest], apiError{Code: codeInvalidRequest, Message: message, Fields: fields})
+}
+
+// requestLanguage 返回錯誤信息使用的語言
+// 優先使用 lang 參數，參數缺失或不支援時根據 Accept-Language 標頭選擇
+func requestLanguage(c *gin.Context) string {
+	if lang := strings.ToLower(c.Query("lang")); lang != "" && i18n.Supported(lang) {
+		return lang
+	}
+	return i18n.Negotiate(c.GetHeader("Accept-Language"))
+}
+
+// localizeError 翻譯查詢錯誤的信息
+// 只翻譯帶有錯誤類別的錯誤信息，底層的系統錯誤（如 connection refused）保持原樣
+func localizeError(lang string, err error) string {
+	switch e := err.(type) {
+	case *mcstatus.Error:
+		msg := i18n.Translate(lang, e.Message)
+		if e.Err != nil {
+			msg += ": " + localizeError(lang, e.Err)
+		}
+		return msg
+	case mcstatus.MultiError:
+		msgs := make([]string, len(e))
+		for i, err := range e {
+			msgs[i] = localizeError(lang, err)
+		}
+		return strings.Join(msgs, "; ")
+	default:
+		return err.Error()
+	}
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"backend/internal/feature"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// featureState 是功能開關的狀態
+type featureState struct {
+	Name        feature.Flag `json:"name"`
+	Description string       `json:"description"`
+	Enabled     bool         `json:"enabled"` // 當前是否啟用
+	Default     bool         `json:"default"` // 啟動時的狀態，重新啟動後恢復為該值
+}
+
+// featuresResponse 是 GetFeatures 的回應
+type featuresResponse struct {
+	Features []featureState `json:"features"`
+}
+
+// setFeatureQuery 是 SetFeature 的查詢參數
+type setFeatureQuery struct {
+	Enabled string `form:"enabled" required:"true" enum:"true,false" description:"是否啟用該功能"`
+}
+
+// RequireFeature 只在功能啟用時處理請求，用於整組路由都屬於同一功能的情況
+func RequireFeature(name feature.Flag) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !feature.Enabled(name) {
+			abortWithError(c, codeFeatureDisabled, "功能已停用: %s", name)
+		}
+	}
+}
+
+// GetFeatures 列出所有功能開關及其狀態
+func GetFeatures(c *gin.Context) {
+	respondJSON(c, http.StatusOK, featuresResponse{Features: featureStates()})
+}
+
+// SetFeature 在運行時啟用或停用功能，變化不會保存，重新啟動後恢復 FEATURE_FLAGS 中的設定
+func SetFeature(c *gin.Context) {
+	var q setFeatureQuery
+	if !bindQuery(c, &q) {
+		return
+	}
+	name := feature.Flag(c.Param("name"))
+	if err := feature.Set(name, q.Enabled == "true"); err != nil {
+		if errors.Is(err, feature.ErrUnknownFlag) {
+			abortWithError(c, codeInvalidRequest, "未知的功能開關: %s", name)
+			return
+		}
+		respondError(c, err)
+		return
+	}
+	respondJSON(c, http.StatusOK, featuresResponse{Features: featureStates()})
+}
+
+// featureStates 返回所有功能開關的狀態
+func featureStates() []featureState {
+	list := feature.List()
+	states := make([]featureState, len(list))
+	for i, s := range list {
+		states[i] = featureState{
+			Name:        s.Name,
+			Description: s.Description,
+			Enabled:     s.Enabled,
+			Default:     s.Default,
+		}
+	}
+	return states
+}
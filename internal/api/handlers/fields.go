@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// alwaysSelected 是指定了 fields 參數時總是保留的字段
+var alwaysSelected = []string{"edition", "debug"}
+
+// selectFields 只保留 data 中 fields（以逗號分隔）列出的頂層字段，fields 為空時原樣返回
+// motd 是 Java 版 description 字段的別名；未列出的字段（包括通常最大的 favicon）都會被省略
+// JSON 和 XML 格式保持字段原有的順序，MessagePack 格式的字段順序不固定
+func selectFields(c *gin.Context, data any, fields string) any {
+	if fields == "" {
+		return data
+	}
+	wanted := make(map[string]bool)
+	for _, f := range strings.Split(fields, ",") {
+		wanted[strings.TrimSpace(f)] = true
+	}
+	if wanted["motd"] {
+		wanted["description"] = true
+	}
+	for _, f := range alwaysSelected {
+		wanted[f] = true
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return data
+	}
+	selected, err := filterObject(raw, wanted)
+	if err != nil {
+		return data
+	}
+	if responseFormat(c) == formatMsgPack {
+		var m map[string]any
+		if err := json.Unmarshal(selected, &m); err != nil {
+			return data
+		}
+		return m
+	}
+	return selected
+}
+
+// filterObject 從 JSON 對象中保留 wanted 中的字段，保持字段原有的順序
+func filterObject(raw []byte, wanted map[string]bool) (json.RawMessage, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return raw, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		key, _ := tok.(string)
+		if !wanted[key] {
+			continue
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(key)
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
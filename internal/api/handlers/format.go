@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	pb "backend/internal/rpc/mcstatuspb"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ugorji/go/codec"
+	"google.golang.org/protobuf/proto"
+)
+
+// 支援的回應格式
+const (
+	formatJSON     = "json"
+	formatXML      = "xml"
+	formatMsgPack  = "msgpack"
+	formatProtobuf = "protobuf"
+)
+
+// formatMediaTypes 是各回應格式在 Accept 標頭中對應的媒體類型
+var formatMediaTypes = map[string]string{
+	gin.MIMEJSON:                      formatJSON,
+	gin.MIMEXML:                       formatXML,
+	gin.MIMEXML2:                      formatXML,
+	"application/msgpack":             formatMsgPack,
+	"application/x-msgpack":           formatMsgPack,
+	"application/vnd.msgpack":         formatMsgPack,
+	"application/protobuf":            formatProtobuf,
+	"application/x-protobuf":          formatProtobuf,
+	"application/vnd.google.protobuf": formatProtobuf,
+}
+
+// msgpackHandle 是 MessagePack 編碼設定，啟用 WriteExt 使時間以標準的 timestamp 擴展類型編碼，
+// 二進制數據以 bin 類型編碼
+var msgpackHandle = &codec.MsgpackHandle{WriteExt: true}
+
+// responseFormat 返回請求要求的回應格式
+// format 參數優先，否則選擇 Accept 標頭中權重最高的格式，權重相同時明確列出的類型優先於 */*。
+// 瀏覽器的 Accept 標頭通常包含 text/html 和權重較高的 application/xml，這種情況下仍然使用 JSON
+func responseFormat(c *gin.Context) string {
+	switch format := c.Query("format"); format {
+	case formatXML, formatMsgPack, formatProtobuf:
+		return format
+	}
+
+	best, bestQ, bestExplicit := formatJSON, 0.0, false
+	for _, part := range strings.Split(c.GetHeader("Accept"), ",") {
+		mediaType, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+				q = parsed
+			}
+		}
+
+		format, explicit := formatMediaTypes[mediaType]
+		switch {
+		case mediaType == "text/html":
+			return formatJSON
+		case mediaType == "*/*" || mediaType == "application/*":
+			format = formatJSON
+		case !explicit:
+			continue
+		}
+		if q > bestQ || q == bestQ && explicit && !bestExplicit {
+			best, bestQ, bestExplicit = format, q, explicit
+		}
+	}
+	return best
+}
+
+// render 根據請求的格式返回回應
+// Protobuf 只適用於有對應 protobuf 消息的回應，其他值使用 JSON
+func render(c *gin.Context, status int, v any) {
+	c.Header("Vary", "Accept")
+	switch responseFormat(c) {
+	case formatXML:
+		renderXML(c, status, v)
+	case formatMsgPack:
+		var data []byte
+		if err := codec.NewEncoderBytes(&data, msgpackHandle).Encode(v); err != nil {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.Data(status, "application/msgpack", data)
+	case formatProtobuf:
+		if m, ok := v.(proto.Message); ok {
+			c.ProtoBuf(status, m)
+			return
+		}
+		c.JSON(status, v)
+	default:
+		if callback := c.Query("callback"); callback != "" && validCallback(callback) {
+			renderJSONP(c, callback, v)
+			return
+		}
+		c.JSON(status, v)
+	}
+}
+
+// callbackPattern 是允許的 JSONP 回調函數名稱，只接受以點分隔的 JavaScript 標識符
+var callbackPattern = regexp.MustCompile(`^[A-Za-z_$][0-9A-Za-z_$]*(\.[A-Za-z_$][0-9A-Za-z_$]*)*$`)
+
+// maxCallbackLength 是 JSONP 回調函數名稱的最大長度，需要與 serverStatusQuery 中 callback 的 maxLength 標籤一致
+const maxCallbackLength = 64
+
+// validCallback 檢查 JSONP 回調函數名稱是否安全
+func validCallback(callback string) bool {
+	return len(callback) <= maxCallbackLength && callbackPattern.MatchString(callback)
+}
+
+// renderJSONP 以 JSONP 格式返回回應
+// 瀏覽器不會執行狀態碼不是 2xx 的腳本，所以錯誤回應也使用 200 狀態碼，錯誤信息在回應內容中
+func renderJSONP(c *gin.Context, callback string, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	c.Header("X-Content-Type-Options", "nosniff")
+	// 開頭的註釋可以防止回應被當作其他類型的內容解析
+	c.Data(http.StatusOK, "application/javascript; charset=utf-8", []byte("/**/"+callback+"("+string(data)+");"))
+}
+
+// protobufError 將錯誤轉換為 protobuf 消息
+func protobufError(e apiError) *pb.Error {
+	return &pb.Error{Code: string(e.Code), Message: e.Message}
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"backend/mcstatus"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// serverIconQuery 是 GetServerIcon 的查詢參數
+type serverIconQuery struct {
+	Address string `form:"address" required:"true" maxLength:"260" description:"Minecraft 伺服器的地址，可帶端口"`
+	Size    int    `form:"size" minimum:"16" maximum:"256" description:"縮放後的邊長，預設保持原尺寸（64×64）"`
+	Format  string `form:"format" enum:"png,webp" default:"png" description:"圖片格式"`
+	Lang    string `form:"lang" maxLength:"35" description:"錯誤信息的語言，例如 en"`
+}
+
+// GetServerIcon 以圖片的形式返回伺服器圖標，支援縮放和格式轉換
+func GetServerIcon(c *gin.Context) {
+	var q serverIconQuery
+	if !bindQuery(c, &q) {
+		return
+	}
+
+	format := q.Format
+	if format == "" {
+		format = "png"
+	}
+
+	status, err := cachedJavaStatus(c, q.Address)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	png, err := mcstatus.DecodeFavicon(status.Favicon)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	data, contentType, err := mcstatus.ConvertFavicon(png, q.Size, format)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// 圖標很少變化，允許瀏覽器和 CDN 緩存一段時間
+	c.Header("Cache-Control", "public, max-age=3600")
+	c.Data(http.StatusOK, contentType, data)
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"backend/mcstatus"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// inflightQuery 是一個正在進行的出站查詢
+type inflightQuery struct {
+	Edition   string `json:"edition"`
+	Target    string `json:"target"`           // 查詢的地址
+	Client    string `json:"client,omitempty"` // 發起查詢的客戶端 IP，後台刷新和預熱的查詢沒有該字段
+	ElapsedMs int64  `json:"elapsed_ms"`       // 已經進行的毫秒數
+}
+
+// inflightResponse 是 GetInflightQueries 的回應
+type inflightResponse struct {
+	Queries []inflightQuery `json:"queries"`
+}
+
+// GetInflightQueries 列出正在進行的出站查詢，用於排查卡住的查詢
+func GetInflightQueries(c *gin.Context) {
+	now := time.Now()
+	infos := mcstatus.InflightQueries()
+	queries := make([]inflightQuery, len(infos))
+	for i, q := range infos {
+		queries[i] = inflightQuery{
+			Edition:   q.Edition,
+			Target:    q.Target,
+			Client:    q.Client,
+			ElapsedMs: now.Sub(q.Started).Milliseconds(),
+		}
+	}
+	respondJSON(c, http.StatusOK, inflightResponse{Queries: queries})
+}
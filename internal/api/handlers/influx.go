@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// influxMeasurement 是 InfluxDB line protocol 輸出使用的 measurement 名稱
+const influxMeasurement = "minecraft_server"
+
+// influxQuery 是 GetInfluxMetrics 的查詢參數
+type influxQuery struct {
+	Addresses string `form:"addresses" required:"true" description:"以逗號分隔的伺服器地址列表，最多 20 個"`
+	Edition   string `form:"edition" enum:"java,bedrock,auto" default:"java" description:"伺服器版本，auto 會依次嘗試 Java 版和基岩版"`
+	Lang      string `form:"lang" maxLength:"35" description:"錯誤信息的語言，例如 en"`
+}
+
+// influxTagEscaper 轉義 line protocol 中 tag 值的特殊字符
+var influxTagEscaper = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+
+// GetInfluxMetrics 並發查詢多個伺服器，以 InfluxDB line protocol 格式返回在線狀態、人數和延遲
+// 可配合 Telegraf 的 http 輸入插件定期採集
+func GetInfluxMetrics(c *gin.Context) {
+	var q influxQuery
+	if !bindQuery(c, &q) {
+		return
+	}
+
+	addresses, edition, ok := parseServerList(c, q.Addresses, q.Edition)
+	if !ok {
+		return
+	}
+
+	now := time.Now().UnixNano()
+	var b strings.Builder
+	for _, r := range queryServers(addresses, edition, requestLanguage(c)) {
+		fmt.Fprintf(&b, "%s,address=%s", influxMeasurement, influxTagEscaper.Replace(r.Address))
+		if r.Edition != "" {
+			fmt.Fprintf(&b, ",edition=%s", r.Edition)
+		}
+		fmt.Fprintf(&b, " online=%t,players_online=%di,players_max=%di,latency_ms=%di %d\n",
+			r.Online, r.Players, r.Max, r.LatencyMS, now)
+	}
+	c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(b.String()))
+}
@@ -0,0 +1,13 @@
+package handlers
+
+import (
+	"expvar"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetMetrics 以 expvar 的 JSON 格式返回運行指標，包括慢查詢統計和 Go 運行時的內存統計
+// 回應不使用統一的回應格式，可以直接由監控系統的 expvar 採集器讀取
+func GetMetrics(c *gin.Context) {
+	expvar.Handler().ServeHTTP(c.Writer, c.Request)
+}
@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"backend/mcstatus"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// addressQuery 是只需要伺服器地址的查詢參數
+type addressQuery struct {
+	Address string `form:"address" required:"true" maxLength:"260" description:"Minecraft 伺服器的地址，可帶端口"`
+	Lang    string `form:"lang" maxLength:"35" description:"錯誤信息的語言，例如 en"`
+}
+
+// GetMOTDHTML 返回伺服器 MOTD 渲染後的 HTML 片段
+func GetMOTDHTML(c *gin.Context) {
+	var q addressQuery
+	if !bindQuery(c, &q) {
+		return
+	}
+
+	status, err := cachedJavaStatus(c, q.Address)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(mcstatus.RenderHTML(status.Description.Components)))
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/gin-gonic/gin"
+)
+
+// requestIDHeader 是傳遞請求 ID 的標頭
+const requestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength 是接受的客戶端請求 ID 的最大長度
+const maxRequestIDLength = 128
+
+// panics 統計處理請求時發生 panic 的次數
+var panics = expvar.NewInt("panics")
+
+// RequestID 為每個請求分配一個 ID，通過 X-Request-ID 標頭返回，並附帶在 /api/v1 回應的 meta 中
+// 請求已帶有有效的 X-Request-ID 時（例如由反向代理生成）沿用該 ID，便於關聯兩邊的日誌
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestIDHeader)
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+		c.Set(requestIDKey, id)
+		c.Header(requestIDHeader, id)
+	}
+}
+
+// validRequestID 檢查客戶端提供的請求 ID 是否只包含字母、數字和 ._- 且長度合理，避免注入日誌
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '.' || r == '_' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// newRequestID 生成一個隨機的請求 ID
+func newRequestID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// Recovery 從處理請求時的 panic 中恢復，以統一的錯誤格式返回 INTERNAL_ERROR 和請求 ID，
+// 並記錄包含請求 ID 和堆棧的結構化日誌，同時增加 expvar 中的 panics 計數
+func Recovery() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			r := recover()
+			if r == nil {
+				return
+			}
+			// 由 net/http 處理的中止請求，不是錯誤
+			if r == http.ErrAbortHandler {
+				panic(r)
+			}
+
+			panics.Add(1)
+			slog.Error("處理請求時發生 panic",
+				"request_id", c.GetString(requestIDKey),
+				"method", c.Request.Method,
+				"path", c.Request.URL.Path,
+				"panic", fmt.Sprint(r),
+				"stack", string(debug.Stack()),
+			)
+
+			// 回應已經開始發送時無法再返回錯誤回應
+			if c.Writer.Written() {
+				c.Abort()
+				return
+			}
+			abortWithError(c, codeInternalError, "處理請求時發生內部錯誤")
+		}()
+		c.Next()
+	}
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"backend/internal/i18n"
+	"backend/mcstatus"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// regionTimeout 是向其他區域的實例發出查詢的時限
+const regionTimeout = 15 * time.Second
+
+// probeRegion 是部署在其他區域、可以代為查詢伺服器狀態的實例
+type probeRegion struct {
+	Name string
+	URL  string
+}
+
+var (
+	localRegion  = "local"
+	probeRegions []probeRegion
+	regionClient = &http.Client{Timeout: regionTimeout}
+)
+
+// SetProbeRegions 設置本實例的區域名稱和其他區域的實例
+// regions 的每一項格式為 "名稱=地址"，例如 "eu=https://eu.example.com"
+func SetProbeRegions(local string, regions []string) error {
+	if local != "" {
+		localRegion = local
+	}
+	probeRegions = nil
+	for _, r := range regions {
+		name, base, ok := strings.Cut(r, "=")
+		if !ok || name == "" {
+			return fmt.Errorf("無效的區域設定: %q", r)
+		}
+		if u, err := url.Parse(base); err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("無效的區域地址: %q", base)
+		}
+		probeRegions = append(probeRegions, probeRegion{Name: name, URL: strings.TrimSuffix(base, "/")})
+	}
+	return nil
+}
+
+// regionsQuery 是 GetRegionalStatus 的查詢參數
+type regionsQuery struct {
+	Address string `form:"address" required:"true" maxLength:"260" description:"Minecraft 伺服器的地址，可帶端口"`
+	Edition string `form:"edition" enum:"java,bedrock,auto" default:"java" description:"伺服器版本，auto 會依次嘗試 Java 版和基岩版"`
+	Format  string `form:"format" enum:"json,xml,msgpack" default:"json" description:"回應格式，也可以通過 Accept 標頭選擇"`
+	Lang    string `form:"lang" maxLength:"35" description:"錯誤信息的語言，例如 en"`
+}
+
+// regionResult 是單個區域的查詢結果
+type regionResult struct {
+	Region string `json:"region"`
+	compareResult
+}
+
+// regionsResponse 是 GetRegionalStatus 的回應
+type regionsResponse struct {
+	Address string         `json:"address"`
+	Online  bool           `json:"online"`  // 只有多數成功回應的區域都認為伺服器離線時才為 false
+	Regions []regionResult `json:"regions"` // 各區域的結果，第一項為本實例
+}
+
+// GetRegionalStatus 同時從本實例和其他區域的實例查詢伺服器狀態，比較各區域的延遲並綜合判斷伺服器是否在線
+func GetRegionalStatus(c *gin.Context) {
+	var q regionsQuery
+	if !bindQuery(c, &q) {
+		return
+	}
+	address := strings.TrimSpace(q.Address)
+	if address == "" {
+		abortWithError(c, codeInvalidRequest, "伺服器地址不能為空")
+		return
+	}
+	edition := q.Edition
+	if edition == "" {
+		edition = mcstatus.EditionJava
+	}
+
+	lang := requestLanguage(c)
+	c.Header("Content-Language", lang)
+
+	results := make([]regionResult, len(probeRegions)+1)
+	var wg sync.WaitGroup
+	wg.Add(len(results))
+	go func() {
+		defer wg.Done()
+		results[0] = regionResult{Region: localRegion, compareResult: compareServer(address, edition, lang)}
+	}()
+	for i, region := range probeRegions {
+		go func() {
+			defer wg.Done()
+			results[i+1] = regionResult{Region: region.Name, compareResult: queryRegion(region, address, edition, lang)}
+		}()
+	}
+	wg.Wait()
+
+	respondJSON(c, http.StatusOK, regionsResponse{Address: address, Online: agreeOnline(results), Regions: results})
+}
+
+// queryRegion 通過其他區域實例的 /api/v1/compare 查詢伺服器狀態
+func queryRegion(region probeRegion, address, edition, lang string) compareResult {
+	params := url.Values{"addresses": {address}, "edition": {edition}, "lang": {lang}}
+	unavailable := func(format string, args ...any) compareResult {
+		return compareResult{Address: address, Error: &apiError{Code: codeRegionUnavailable, Message: fmt.Sprintf(i18n.Translate(lang, format), args...)}}
+	}
+
+	resp, err := regionClient.Get(region.URL + "/api/v1/compare?" + params.Encode())
+	if err != nil {
+		return unavailable("無法連接到區域 %s 的實例", region.Name)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		Data *compareResponse `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil || body.Data == nil || len(body.Data.Servers) != 1 {
+		return unavailable("區域 %s 的實例返回了無效的回應", region.Name)
+	}
+	return body.Data.Servers[0]
+}
+
+// agreeOnline 綜合各區域的結果，只有多數成功回應的區域都認為伺服器離線時才判定為離線
+// 無法連接的區域不參與判斷，避免單個區域的網絡問題造成誤判
+func agreeOnline(results []regionResult) bool {
+	var online, offline int
+	for _, r := range results {
+		switch {
+		case r.Online:
+			online++
+		case r.Error == nil || r.Error.Code != codeRegionUnavailable:
+			offline++
+		}
+	}
+	return online > 0 && offline*2 <= online+offline
+}
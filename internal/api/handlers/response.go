@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"backend/mcstatus"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// envelopeKey 是標記請求需要使用統一回應格式的 context 鍵
+const envelopeKey = "envelope"
+
+// 保存回應附加信息的 context 鍵
+const (
+	reverseDNSKey = "reverse_dns" // 伺服器 IP 的反向 DNS 名稱
+	cacheInfoKey  = "cache_info"  // 查詢結果與緩存的關係
+	requestIDKey  = "request_id"  // 請求 ID
+	queryKey      = "query"       // 狀態查詢的結果，用於生成查詢元數據
+)
+
+// envelope 是 /api/v1 的統一回應格式，成功時 error 為 null，失敗時 data 為 null
+type envelope struct {
+	Data  any       `json:"data"`
+	Error *apiError `json:"error"`
+	Meta  meta      `json:"meta"`
+}
+
+// meta 是回應的附加信息
+type meta struct {
+	Timestamp  time.Time  `json:"timestamp"`             // 回應生成的時間
+	Cached     bool       `json:"cached"`                // 回應是否來自緩存
+	Stale      bool       `json:"stale"`                 // 緩存的結果是否已經過期
+	Age        *int64     `json:"age,omitempty"`         // 緩存的結果距離實際查詢的秒數，只在回應來自緩存時提供
+	ReverseDNS string     `json:"reverse_dns,omitempty"` // 伺服器 IP 的反向 DNS 名稱，只在請求時查詢
+	RequestID  string     `json:"request_id,omitempty"`  // 請求 ID，與 X-Request-ID 標頭相同
+	Query      *queryMeta `json:"query,omitempty"`       // 實際查詢的信息，只在狀態查詢的回應中提供
+}
+
+// queryMeta 描述狀態查詢實際連接的目標和耗時，結果來自緩存時為當時查詢的信息
+type queryMeta struct {
+	IP         string    `json:"ip"`                   // 實際連接的 IP 地址
+	Port       int       `json:"port"`                 // 實際連接的端口
+	SRVTarget  string    `json:"srv_target,omitempty"` // SRV 記錄指向的主機，未使用 SRV 記錄時省略
+	Protocol   string    `json:"protocol"`             // 使用的查詢協議：modern（Java 版 SLP）或 bedrock（RakNet Ping）
+	LatencyMS  int64     `json:"latency_ms"`           // 建立連接（基岩版為 Ping 往返）所用的毫秒數
+	DurationMS int64     `json:"duration_ms"`          // 整個查詢（包括 DNS 解析）所用的毫秒數
+	QueriedAt  time.Time `json:"queried_at"`           // 開始查詢的時間
+}
+
+// 查詢元數據中的協議名稱
+const (
+	queryProtocolModern  = "modern"
+	queryProtocolBedrock = "bedrock"
+)
+
+// newQueryMeta 從查詢結果生成查詢元數據
+func newQueryMeta(result *mcstatus.EditionStatus) *queryMeta {
+	if s := result.Bedrock; s != nil {
+		return &queryMeta{
+			IP: s.IP, Port: s.Port, Protocol: queryProtocolBedrock,
+			LatencyMS: s.Latency.Milliseconds(), DurationMS: s.Duration.Milliseconds(), QueriedAt: s.QueriedAt.UTC(),
+		}
+	}
+	if s := result.Java; s != nil {
+		return &queryMeta{
+			IP: s.IP, Port: s.Port, SRVTarget: s.SRVTarget, Protocol: queryProtocolModern,
+			LatencyMS: s.Latency.Milliseconds(), DurationMS: s.Duration.Milliseconds(), QueriedAt: s.QueriedAt.UTC(),
+		}
+	}
+	return nil
+}
+
+// UseEnvelope 讓之後的處理器使用統一回應格式
+func UseEnvelope() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(envelopeKey, true)
+	}
+}
+
+// Deprecated 為舊版路由加上 Deprecation 標頭，並通過 Link 標頭指向對應的新路由
+func Deprecated(prefix, successorPrefix string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		successor := successorPrefix + strings.TrimPrefix(c.Request.URL.Path, prefix)
+		c.Header("Deprecation", "true")
+		c.Header("Link", "<"+successor+">; rel=\"successor-version\"")
+	}
+}
+
+// enveloped 檢查請求是否需要使用統一回應格式
+func enveloped(c *gin.Context) bool {
+	return c.GetBool(envelopeKey)
+}
+
+// bindQuery 將查詢參數解析到結構體中並根據標籤驗證（見 queryValidator），解析或驗證失敗時返回錯誤回應
+func bindQuery(c *gin.Context, q any) bool {
+	if err := c.ShouldBindQuery(q); err != nil {
+		var fields fieldErrors
+		if errors.As(err, &fields) {
+			abortWithFieldErrors(c, fields)
+			return false
+		}
+		abortWithError(c, codeInvalidRequest, "無效的請求參數: %v", err)
+		return false
+	}
+	return true
+}
+
+// bindRequest 與 bindQuery 相同，並將路徑參數解析到帶有 uri 標籤的字段中
+func bindRequest(c *gin.Context, q any) bool {
+	params := make(map[string][]string, len(c.Params))
+	for _, p := range c.Params {
+		params[p.Key] = []string{p.Value}
+	}
+	if err := binding.MapFormWithTag(q, params, "uri"); err != nil {
+		abortWithError(c, codeInvalidRequest, "無效的請求參數: %v", err)
+		return false
+	}
+	return bindQuery(c, q)
+}
+
+// respondJSON 返回 JSON 回應，需要時包裝為統一回應格式
+// 成功的回應帶有 ETag 標頭，與請求的 If-None-Match 相符時返回 304
+func respondJSON(c *gin.Context, status int, data any) {
+	if status == http.StatusOK && notModified(c, data) {
+		return
+	}
+	if enveloped(c) {
+		data = envelope{Data: data, Meta: newMeta(c)}
+	}
+	render(c, status, data)
+}
+
+// sendError 返回錯誤回應並中止後續處理，需要時包裝為統一回應格式
+// 舊版路由的錯誤回應不包裝，錯誤信息位於 error 字段；Protobuf 格式的錯誤回應為 Error 消息
+func sendError(c *gin.Context, status int, e apiError) {
+	c.Abort()
+	if responseFormat(c) == formatProtobuf {
+		render(c, status, protobufError(e))
+		return
+	}
+	if enveloped(c) {
+		render(c, status, envelope{Error: &e, Meta: newMeta(c)})
+		return
+	}
+	body := gin.H{"error": e.Message, "code": e.Code}
+	if e.Fields != nil {
+		body["fields"] = e.Fields
+	}
+	if e.Debug != nil {
+		body["debug"] = e.Debug
+	}
+	render(c, status, body)
+}
+
+// setCacheInfo 記錄查詢結果與緩存的關係，回應來自緩存時設置 Age 標頭
+func setCacheInfo(c *gin.Context, info mcstatus.CacheInfo) {
+	c.Set(cacheInfoKey, info)
+	if info.Cached {
+		c.Header("Age", strconv.FormatInt(int64(info.Age.Seconds()), 10))
+	}
+}
+
+// notModified 根據回應內容計算 ETag 並設置到回應標頭中，與 If-None-Match 相符時返回 304 並返回 true
+// ETag 只根據 data 計算，不包含每次回應都不同的 meta，因此是弱驗證器；
+// 同一內容的不同表示（回應格式、JSONP、是否包裝）使用不同的 ETag
+func notModified(c *gin.Context, data any) bool {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return false
+	}
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n%t\n", responseFormat(c), c.Query("callback"), enveloped(c))
+	h.Write(body)
+	etag := `W/"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+	c.Header("ETag", etag)
+
+	for _, tag := range strings.Split(c.GetHeader("If-None-Match"), ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+			c.Header("Vary", "Accept")
+			c.AbortWithStatus(http.StatusNotModified)
+			return true
+		}
+	}
+	return false
+}
+
+// newMeta 創建當前回應的附加信息
+func newMeta(c *gin.Context) meta {
+	m := meta{Timestamp: time.Now().UTC(), ReverseDNS: c.GetString(reverseDNSKey), RequestID: c.GetString(requestIDKey)}
+	if result, ok := c.Value(queryKey).(*mcstatus.EditionStatus); ok {
+		m.Query = newQueryMeta(result)
+	}
+	if info, ok := c.Value(cacheInfoKey).(mcstatus.CacheInfo); ok && info.Cached {
+		age := int64(info.Age.Seconds())
+		m.Cached, m.Stale, m.Age = true, info.Stale, &age
+	}
+	return m
+}
@@ -1,24 +1,236 @@
 package handlers
 
 import (
-	mcstatus "backend/internal/service"
+	"backend/internal/enrich"
+	"backend/internal/feature"
+	"backend/internal/rpc"
+	"backend/mcstatus"
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
+// 附加查詢的時限
+const (
+	profileTimeout    = 5 * time.Second // 查詢玩家資料的總時限，超時的玩家不附帶資料
+	reverseDNSTimeout = 2 * time.Second // 反向 DNS 查詢的時限，超時時不附帶結果
+	blocklistTimeout  = 5 * time.Second // 下載 Mojang 封鎖列表的時限
+)
+
+// serverStatusQuery 是 GetServerStatus 的查詢參數
+type serverStatusQuery struct {
+	Address      string `form:"address" required:"true" maxLength:"260" description:"Minecraft 伺服器的地址，可帶端口"`
+	Edition      string `form:"edition" enum:"java,bedrock,auto" default:"java" description:"伺服器版本，auto 會依次嘗試 Java 版和基岩版"`
+	MOTD         string `form:"motd" enum:"clean,ansi" description:"MOTD 的輸出格式，會將 description 替換為渲染後的字符串"`
+	Protocol     int32  `form:"protocol" minimum:"-1" description:"握手時宣告的客戶端協議版本"`
+	Bind         string `form:"bind" maxLength:"64" description:"出站連接綁定的本地 IP 或網卡名稱（僅限管理員）"`
+	Debug        bool   `form:"debug" description:"附帶數據包轉儲及各階段耗時（僅限管理員）"`
+	Raw          bool   `form:"raw" description:"附帶伺服器返回的原始 JSON（僅限 Java 版）"`
+	HTML         bool   `form:"html" description:"在 description.html 中附帶渲染後的 MOTD HTML"`
+	Extended     bool   `form:"extended" description:"附帶伺服器 IP 所屬的 ASN 和組織、是否被 Mojang 封鎖等擴展信息"`
+	RDNS         bool   `form:"rdns" description:"查詢伺服器 IP 的反向 DNS（PTR）記錄，結果位於 meta.reverse_dns"`
+	Votifier     bool   `form:"votifier" description:"檢查 Votifier 投票端口是否可連接及其協議版本（僅限 Java 版）"`
+	VotifierPort int    `form:"votifier_port" minimum:"1" maximum:"65535" default:"8192" description:"Votifier 的端口"`
+	Refresh      bool   `form:"refresh" description:"忽略緩存的結果，直接查詢並更新緩存"`
+	StaleIfError bool   `form:"stale_if_error" description:"查詢失敗時返回緩存中最後一次成功的結果（需要啟用緩存）"`
+	LoginCheck   bool   `form:"login_check" description:"開始登入流程（不完成驗證）以推斷伺服器是否為正版驗證模式及是否啟用了白名單（僅限 Java 版）"`
+	Fields       string `form:"fields" maxLength:"256" pattern:"^[A-Za-z0-9_, ]+$" description:"以逗號分隔的頂層字段列表，例如 players,version,motd，只返回這些字段（favicon 只在列出時返回）"`
+	Enrich       bool   `form:"enrich_players" description:"通過 Mojang 會話伺服器查詢玩家列表樣本的皮膚和正確大小寫的名稱（僅限 Java 版）"`
+	Format       string `form:"format" enum:"json,xml,msgpack,protobuf" default:"json" description:"回應格式，也可以通過 Accept 標頭選擇"`
+	Callback     string `form:"callback" maxLength:"64" description:"JSONP 回調函數名稱，用於不支援 CORS 的靜態網頁"`
+	Lang         string `form:"lang" maxLength:"35" description:"錯誤信息的語言，例如 en"`
+}
+
+// GetServerStatus 查詢伺服器狀態
 func GetServerStatus(c *gin.Context) {
-	address := c.Query("address")
-	if address == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "伺服器地址不能為空"})
+	var q serverStatusQuery
+	if !bindQuery(c, &q) {
+		return
+	}
+
+	if q.Callback != "" && !validCallback(q.Callback) {
+		abortWithError(c, codeInvalidRequest, "無效的回調函數名稱: %s", q.Callback)
+		return
+	}
+
+	edition := q.Edition
+	if edition == "" {
+		edition = mcstatus.EditionJava
+	}
+	renderMOTD := motdRenderers[q.MOTD]
+
+	if q.LoginCheck && !feature.Enabled(feature.LoginCheck) {
+		abortWithError(c, codeFeatureDisabled, "功能已停用: %s", feature.LoginCheck)
+		return
+	}
+
+	opts := mcstatus.QueryOptions{
+		ProtocolVersion: q.Protocol,
+		IncludeRaw:      q.Raw,
+		CheckLogin:      q.LoginCheck,
+	}
+	if q.Bind != "" {
+		if !isAdmin(c) {
+			abortWithError(c, codeForbidden, "只有管理員可以指定出站地址")
+			return
+		}
+		opts.BindAddress = q.Bind
+	}
+	if q.Refresh && refreshAdminOnly && !isAdmin(c) {
+		abortWithError(c, codeForbidden, "只有管理員可以強制刷新緩存")
 		return
 	}
+	if q.Debug {
+		if !isAdmin(c) {
+			abortWithError(c, codeForbidden, "只有管理員可以使用調試模式")
+			return
+		}
+		opts.Trace = mcstatus.NewDebugTrace()
+	}
+
+	// 只有不帶額外選項的查詢才使用緩存
+	cacheable := opts == (mcstatus.QueryOptions{})
+	opts.Client = c.ClientIP()
 
-	status, err := mcstatus.GetServerStatus(address)
+	var result *mcstatus.EditionStatus
+	var cache mcstatus.CacheInfo
+	var err error
+	if cacheable {
+		result, cache, err = mcstatus.GetCachedStatusContext(c.Request.Context(), edition, q.Address, mcstatus.CacheOptions{
+			Refresh:      q.Refresh,
+			StaleIfError: q.StaleIfError,
+			Client:       opts.Client,
+		})
+	} else {
+		result = &mcstatus.EditionStatus{Edition: edition}
+		switch edition {
+		case mcstatus.EditionJava:
+			result.Java, err = mcstatus.GetServerStatusContext(c.Request.Context(), q.Address, opts)
+		case mcstatus.EditionBedrock:
+			result.Bedrock, err = mcstatus.GetBedrockStatusContext(c.Request.Context(), q.Address, opts)
+		default:
+			result, err = mcstatus.GetStatusAutoEditionContext(c.Request.Context(), q.Address, opts)
+		}
+	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		status, e := errorResponse(c, err)
+		e.Debug = opts.Trace
+		sendError(c, status, e)
+		return
+	}
+
+	setCacheInfo(c, cache)
+	c.Set(queryKey, result)
+
+	if responseFormat(c) == formatProtobuf {
+		render(c, http.StatusOK, rpc.StatusProto(result))
+		return
+	}
+
+	if q.Extended {
+		ctx, cancel := context.WithTimeout(c.Request.Context(), blocklistTimeout)
+		extendStatus(ctx, q.Address, result)
+		cancel()
+	}
+	if q.RDNS {
+		ctx, cancel := context.WithTimeout(c.Request.Context(), reverseDNSTimeout)
+		c.Set(reverseDNSKey, mcstatus.LookupReverseDNS(ctx, statusIP(result)))
+		cancel()
+	}
+	if status := result.Java; status != nil {
+		if q.HTML {
+			status.Description.HTML = mcstatus.RenderHTML(status.Description.Components)
+		}
+		if q.Votifier {
+			port := q.VotifierPort
+			if port == 0 {
+				port = mcstatus.DefaultVotifierPort
+			}
+			status.Votifier = mcstatus.CheckVotifier(c.Request.Context(), status.IP, port)
+		}
+		if q.Enrich {
+			ctx, cancel := context.WithTimeout(c.Request.Context(), profileTimeout)
+			mcstatus.EnrichPlayers(ctx, status)
+			cancel()
+		}
+	}
+
+	// 擴展在結果構建完成後才運行，並且只得到副本：超時後仍在運行的擴展不會與回應的序列化同時訪問同一個結果
+	address, _ := mcstatus.NormalizeAddress(q.Address)
+	extensions := enrich.Apply(c.Request.Context(), address, result.Clone())
+
+	if result.Bedrock != nil {
+		if renderMOTD != nil {
+			result.Bedrock.MOTD = renderMOTD(result.Bedrock.MOTDComponents)
+		}
+		respondJSON(c, http.StatusOK, selectFields(c, bedrockStatus{Edition: mcstatus.EditionBedrock, BedrockStatus: result.Bedrock, Extensions: extensions, Debug: opts.Trace}, q.Fields))
+		return
+	}
+
+	status := result.Java
+
+	// 只有明確指定了 edition 參數時才在 Java 版回應中附帶版本字段，保持默認回應格式不變
+	response := javaStatus{ServerStatus: status, Extensions: extensions, Debug: opts.Trace}
+	if q.Edition != "" {
+		response.Edition = mcstatus.EditionJava
+	}
+	if renderMOTD != nil {
+		respondJSON(c, http.StatusOK, selectFields(c, renderedStatus{
+			javaStatus:  response,
+			Description: renderMOTD(status.Description.Components),
+		}, q.Fields))
+		return
+	}
+
+	respondJSON(c, http.StatusOK, selectFields(c, response, q.Fields))
+}
+
+// statusIP 返回查詢結果中伺服器的 IP
+func statusIP(result *mcstatus.EditionStatus) string {
+	if s := result.Bedrock; s != nil {
+		return s.IP
+	}
+	return result.Java.IP
+}
+
+// extendStatus 在查詢結果中附帶擴展信息
+func extendStatus(ctx context.Context, address string, result *mcstatus.EditionStatus) {
+	if s := result.Bedrock; s != nil {
+		s.Network = mcstatus.LookupNetwork(s.IP)
 		return
 	}
+	s := result.Java
+	s.Network = mcstatus.LookupNetwork(s.IP)
+	// 封鎖列表只對 Java 版客戶端生效
+	s.BlockedByMojang = mcstatus.IsBlockedByMojang(ctx, address, s.IP)
+}
+
+// motdRenderers 定義了 motd 參數支援的渲染方式
+var motdRenderers = map[string]func([]mcstatus.MOTDComponent) string{
+	"clean": mcstatus.RenderPlainText,
+	"ansi":  mcstatus.RenderANSI,
+}
+
+// javaStatus 是 Java 版伺服器狀態的回應格式
+type javaStatus struct {
+	Edition string `json:"edition,omitempty"`
+	*mcstatus.ServerStatus
+	Extensions map[string]any       `json:"extensions,omitempty"` // 已註冊的擴展附帶的數據
+	Debug      *mcstatus.DebugTrace `json:"debug,omitempty"`
+}
+
+// bedrockStatus 是基岩版伺服器狀態的回應格式
+type bedrockStatus struct {
+	Edition string `json:"edition"`
+	*mcstatus.BedrockStatus
+	Extensions map[string]any       `json:"extensions,omitempty"` // 已註冊的擴展附帶的數據
+	Debug      *mcstatus.DebugTrace `json:"debug,omitempty"`
+}
 
-	c.JSON(http.StatusOK, status)
+// renderedStatus 將伺服器狀態中的描述替換為渲染後的字符串
+type renderedStatus struct {
+	javaStatus
+	Description string `json:"description"`
 }
@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	"backend/mcstatus"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// targetsResponse 是 GetTargetStats 的回應
+type targetsResponse struct {
+	Targets []mcstatus.TargetStat `json:"targets"`
+}
+
+// GetTargetStats 列出最近查詢過的目標的成功率和錯誤類別，成功率低的目標排在前面
+func GetTargetStats(c *gin.Context) {
+	respondJSON(c, http.StatusOK, targetsResponse{Targets: mcstatus.TargetStats()})
+}
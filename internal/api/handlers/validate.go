@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin/binding"
+)
+
+func init() {
+	binding.Validator = &queryValidator{}
+}
+
+// fieldError 是單個查詢參數的驗證錯誤，format 是中文原文，翻譯後再代入參數
+type fieldError struct {
+	field  string
+	format string
+	args   []any
+}
+
+// fieldErrors 是查詢參數的驗證錯誤列表
+type fieldErrors []fieldError
+
+// Error 返回中文的錯誤信息
+func (e fieldErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, f := range e {
+		msgs[i] = f.field + " " + fmt.Sprintf(f.format, f.args...)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// queryValidator 根據 OpenAPI 文檔使用的標籤驗證查詢參數，使文檔與實際的驗證規則保持一致
+// 支援 required、enum、minimum、maximum、maxLength 和 pattern；除 required 外，未提供（零值）的參數不驗證
+type queryValidator struct {
+	patterns sync.Map // pattern 標籤到已編譯正則表達式的緩存
+}
+
+// ValidateStruct 驗證查詢參數結構體，返回所有不符合的參數
+func (v *queryValidator) ValidateStruct(obj any) error {
+	rv := reflect.ValueOf(obj)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var errs fieldErrors
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		// 路徑參數使用 uri 標籤，並以 form:"-" 避免被同名的查詢參數覆蓋
+		name := f.Tag.Get("form")
+		if name == "-" {
+			name = f.Tag.Get("uri")
+		}
+		if name == "" || name == "-" {
+			continue
+		}
+		if err := v.validateField(f, rv.Field(i)); err != nil {
+			err.field = name
+			errs = append(errs, *err)
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Engine 返回底層的驗證引擎，此實現沒有
+func (v *queryValidator) Engine() any {
+	return nil
+}
+
+// validateField 驗證單個參數，符合時返回 nil
+func (v *queryValidator) validateField(f reflect.StructField, value reflect.Value) *fieldError {
+	if value.IsZero() {
+		if f.Tag.Get("required") == "true" {
+			return &fieldError{format: "不能為空"}
+		}
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		s := value.String()
+		if enum := f.Tag.Get("enum"); enum != "" && !slices.Contains(strings.Split(enum, ","), s) {
+			return &fieldError{format: "必須為以下值之一: %s", args: []any{strings.ReplaceAll(enum, ",", ", ")}}
+		}
+		if n, err := strconv.Atoi(f.Tag.Get("maxLength")); err == nil && utf8.RuneCountInString(s) > n {
+			return &fieldError{format: "長度不能超過 %d 個字符", args: []any{n}}
+		}
+		if pattern := f.Tag.Get("pattern"); pattern != "" && !v.compile(pattern).MatchString(s) {
+			return &fieldError{format: "包含無效的字符"}
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := float64(value.Int())
+		if min, err := strconv.ParseFloat(f.Tag.Get("minimum"), 64); err == nil && n < min {
+			return &fieldError{format: "不能小於 %v", args: []any{min}}
+		}
+		if max, err := strconv.ParseFloat(f.Tag.Get("maximum"), 64); err == nil && n > max {
+			return &fieldError{format: "不能大於 %v", args: []any{max}}
+		}
+	}
+	return nil
+}
+
+// compile 返回 pattern 標籤對應的正則表達式，標籤在結構體中固定，格式錯誤屬於程序錯誤
+func (v *queryValidator) compile(pattern string) *regexp.Regexp {
+	if re, ok := v.patterns.Load(pattern); ok {
+		return re.(*regexp.Regexp)
+	}
+	re := regexp.MustCompile(pattern)
+	v.patterns.Store(pattern, re)
+	return re
+}
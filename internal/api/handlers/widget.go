@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"backend/mcstatus"
+	"html/template"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 小工具刷新間隔的預設值和最小值（秒）
+const (
+	defaultWidgetInterval = 60
+	minWidgetInterval     = 10
+)
+
+// widgetQuery 是 GetWidget 的參數
+type widgetQuery struct {
+	Address  string `uri:"address" form:"-" required:"true" maxLength:"260" pattern:"^[^\\s\\p{C}]+$"`
+	Edition  string `form:"edition" enum:"java,bedrock,auto"`
+	Interval int    `form:"interval"`
+	Lang     string `form:"lang" maxLength:"35"`
+}
+
+// GetWidget 返回可以通過 iframe 嵌入的狀態小工具頁面，頁面會定期通過 JSON API 刷新狀態
+func GetWidget(c *gin.Context) {
+	var q widgetQuery
+	if !bindRequest(c, &q) {
+		return
+	}
+
+	edition := q.Edition
+	if edition == "" {
+		edition = mcstatus.EditionJava
+	}
+
+	interval := q.Interval
+	if interval == 0 {
+		interval = defaultWidgetInterval
+	}
+	interval = max(interval, minWidgetInterval)
+
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	c.Status(http.StatusOK)
+	widgetTemplate.Execute(c.Writer, map[string]any{
+		"Address":  q.Address,
+		"Edition":  edition,
+		"Interval": interval * 1000,
+		"Lang":     q.Lang,
+	})
+}
+
+// widgetTemplate 是狀態小工具的頁面，只依賴 /api/v1/server-status，不載入任何外部資源
+var widgetTemplate = template.Must(template.New("widget").Parse(`<!DOCTYPE html>
+<html lang="zh-Hant">
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>{{.Address}}</title>
+  <style>
+    body { margin: 0; font-family: system-ui, sans-serif; background: transparent; }
+    .widget { display: flex; gap: 10px; align-items: center; padding: 8px; border-radius: 6px; background: #1e1e1e; color: #fff; }
+    .icon { width: 48px; height: 48px; flex: none; border-radius: 4px; background: #404040; image-rendering: pixelated; }
+    .info { min-width: 0; flex: 1; }
+    .header { display: flex; justify-content: space-between; gap: 8px; font-weight: bold; }
+    .address { overflow: hidden; text-overflow: ellipsis; white-space: nowrap; }
+    .status::before { content: "●"; margin-right: 4px; }
+    .online .status { color: #55ff55; }
+    .offline .status { color: #ff5555; }
+    .motd { margin-top: 4px; font-size: 13px; color: #aaa; white-space: pre-wrap; overflow: hidden; max-height: 3em; }
+  </style>
+</head>
+<body>
+  <div class="widget" id="widget">
+    <img class="icon" id="icon" alt="">
+    <div class="info">
+      <div class="header">
+        <span class="address">{{.Address}}</span>
+        <span class="status" id="status">…</span>
+      </div>
+      <div class="motd" id="motd"></div>
+    </div>
+  </div>
+  <script>
+    const address = {{.Address}};
+    const edition = {{.Edition}};
+    const interval = {{.Interval}};
+    const lang = {{.Lang}};
+
+    async function refresh() {
+      const widget = document.getElementById("widget");
+      const status = document.getElementById("status");
+      const motd = document.getElementById("motd");
+      const icon = document.getElementById("icon");
+      try {
+        const params = new URLSearchParams({ address, edition, html: "true" });
+        if (lang) {
+          params.set("lang", lang);
+        }
+        const res = await fetch("/api/v1/server-status?" + params);
+        const body = await res.json();
+        if (body.error) {
+          throw new Error(body.error.message);
+        }
+        const data = body.data;
+        widget.className = "widget online";
+        status.textContent = data.players.online + "/" + data.players.max;
+        if (data.edition === "bedrock") {
+          motd.textContent = data.motd.replace(/§./g, "");
+        } else {
+          // description.html 由伺服器端渲染並已轉義
+          motd.innerHTML = data.description.html;
+        }
+        if (data.favicon) {
+          icon.src = data.favicon;
+        } else {
+          icon.removeAttribute("src");
+        }
+      } catch (err) {
+        widget.className = "widget offline";
+        status.textContent = "offline";
+        motd.textContent = err.message;
+      }
+    }
+
+    refresh();
+    setInterval(refresh, interval);
+  </script>
+</body>
+</html>
+`))
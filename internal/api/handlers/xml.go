@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// xmlRoot 是 XML 回應的根元素名稱
+const xmlRoot = "response"
+
+// renderXML 將值按其 JSON 結構轉換為 XML 並返回
+// 元素名稱與 JSON 字段名相同，數組元素使用 item 元素
+func renderXML(c *gin.Context, status int, v any) {
+	data, err := json.Marshal(v)
+	if err == nil {
+		data, err = jsonToXML(data)
+	}
+	if err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	c.Data(status, "application/xml; charset=utf-8", data)
+}
+
+// jsonToXML 將 JSON 轉換為 XML，保持對象中字段的順序
+func jsonToXML(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	if err := writeXMLValue(dec, enc, xmlRoot); err != nil {
+		return nil, err
+	}
+	if err := enc.Flush(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeXMLValue 從 JSON 解碼器讀取一個值並寫入以 name 為名稱的 XML 元素
+func writeXMLValue(dec *json.Decoder, enc *xml.Encoder, name string) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	start := xmlStartElement(name)
+	switch t := tok.(type) {
+	case json.Delim:
+		if err := enc.EncodeToken(start); err != nil {
+			return err
+		}
+		for dec.More() {
+			child := "item"
+			if t == '{' {
+				key, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				child = key.(string)
+			}
+			if err := writeXMLValue(dec, enc, child); err != nil {
+				return err
+			}
+		}
+		// 讀取結束的 } 或 ]
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+		return enc.EncodeToken(start.End())
+	case nil:
+		return enc.EncodeElement("", start)
+	default:
+		return enc.EncodeElement(fmt.Sprint(t), start)
+	}
+}
+
+// xmlStartElement 創建 XML 元素，字段名不是合法的 XML 名稱時（例如原始 JSON 中的任意鍵）
+// 使用 field 元素並以 name 屬性保存原字段名
+func xmlStartElement(name string) xml.StartElement {
+	if isXMLName(name) {
+		return xml.StartElement{Name: xml.Name{Local: name}}
+	}
+	return xml.StartElement{
+		Name: xml.Name{Local: "field"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "name"}, Value: name}},
+	}
+}
+
+// isXMLName 檢查字符串是否為合法的 XML 元素名稱（只接受 ASCII 字符）
+func isXMLName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_':
+		case i > 0 && (r >= '0' && r <= '9' || r == '-' || r == '.'):
+		default:
+			return false
+		}
+	}
+	// 以 xml 開頭的名稱是保留的
+	return len(name) < 3 || !strings.EqualFold(name[:3], "xml")
+}
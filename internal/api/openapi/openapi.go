@@ -0,0 +1,66 @@
+package openapi
+
+// Version 是生成的文檔所使用的 OpenAPI 版本
+const Version = "3.0.3"
+
+// Document 是 OpenAPI 文檔的根對象
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+// Info 是 API 的基本信息
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// PathItem 是同一路徑下各 HTTP 方法的操作
+type PathItem struct {
+	Get *Operation `json:"get,omitempty"`
+}
+
+// Operation 描述一個 API 操作
+type Operation struct {
+	OperationID string              `json:"operationId"`
+	Summary     string              `json:"summary,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Tags        []string            `json:"tags,omitempty"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+// Parameter 描述一個請求參數
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// Response 描述一種回應
+type Response struct {
+	Description string               `json:"description"`
+	Headers     map[string]Header    `json:"headers,omitempty"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// Header 描述一個回應標頭
+type Header struct {
+	Description string  `json:"description,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// MediaType 描述某種內容類型的回應內容
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components 包含可被引用的共用結構
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
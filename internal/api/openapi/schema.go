@@ -0,0 +1,210 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schema 是 OpenAPI 的數據結構描述
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Default              any                `json:"default,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// Generator 根據 Go 類型生成 Schema
+// 導出的具名結構體會放入 Schemas 並以 $ref 引用，其餘類型直接內聯
+type Generator struct {
+	Schemas map[string]*Schema
+}
+
+// NewGenerator 創建一個 Schema 生成器
+func NewGenerator() *Generator {
+	return &Generator{Schemas: make(map[string]*Schema)}
+}
+
+// SchemaOf 返回值的類型對應的 Schema
+func (g *Generator) SchemaOf(v any) *Schema {
+	return g.schema(reflect.TypeOf(v))
+}
+
+// Define 將 Schema 以指定名稱放入 Schemas，並返回引用它的 Schema
+func (g *Generator) Define(name string, s *Schema) *Schema {
+	g.Schemas[name] = s
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// schema 返回類型對應的 Schema
+func (g *Generator) schema(t reflect.Type) *Schema {
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case rawMessageType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		s := g.schema(t.Elem())
+		if s.Ref == "" {
+			s.Nullable = true
+		}
+		return s
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: g.schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" || !isExported(t.Name()) {
+			return g.structSchema(t)
+		}
+		if _, ok := g.Schemas[t.Name()]; !ok {
+			// 先佔位再生成，以支援遞歸的類型（例如文本組件的 extra）
+			g.Schemas[t.Name()] = &Schema{}
+			*g.Schemas[t.Name()] = *g.structSchema(t)
+		}
+		return &Schema{Ref: "#/components/schemas/" + t.Name()}
+	default:
+		// interface 等無法確定的類型允許任意值
+		return &Schema{}
+	}
+}
+
+// structSchema 生成結構體的 Schema，按 encoding/json 的規則展開匿名嵌入的結構體
+func (g *Generator) structSchema(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	depths := make(map[string]int)
+	g.collectFields(s, t, 0, depths)
+	return s
+}
+
+// collectFields 收集結構體的字段，嵌套層級較淺的同名字段優先
+func (g *Generator) collectFields(s *Schema, t reflect.Type, depth int, depths map[string]int) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		ft := f.Type
+		if f.Anonymous && name == "" {
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				g.collectFields(s, ft, depth+1, depths)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if d, ok := depths[name]; ok && d <= depth {
+			continue
+		}
+		depths[name] = depth
+
+		s.Properties[name] = g.schema(ft)
+		s.Required = removeString(s.Required, name)
+		if !strings.Contains(opts, "omitempty") {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// QueryParameters 根據結構體的 form 標籤生成查詢參數
+// 支援的標籤：description（說明）、enum（以逗號分隔的可選值）、default（默認值）、minimum、maximum、
+// maxLength、pattern 以及 required:"true"，除 description 和 default 外的標籤同時用於驗證請求參數
+func (g *Generator) QueryParameters(v any) []Parameter {
+	t := reflect.TypeOf(v)
+	var params []Parameter
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := f.Tag.Get("form")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		s := g.schema(f.Type)
+		s.Nullable = false
+		if enum := f.Tag.Get("enum"); enum != "" {
+			s.Enum = strings.Split(enum, ",")
+		}
+		if def := f.Tag.Get("default"); def != "" {
+			s.Default = def
+		}
+		if v, err := strconv.ParseFloat(f.Tag.Get("minimum"), 64); err == nil {
+			s.Minimum = &v
+		}
+		if v, err := strconv.ParseFloat(f.Tag.Get("maximum"), 64); err == nil {
+			s.Maximum = &v
+		}
+		if v, err := strconv.Atoi(f.Tag.Get("maxLength")); err == nil {
+			s.MaxLength = &v
+		}
+		s.Pattern = f.Tag.Get("pattern")
+
+		params = append(params, Parameter{
+			Name:        name,
+			In:          "query",
+			Description: f.Tag.Get("description"),
+			Required:    f.Tag.Get("required") == "true",
+			Schema:      s,
+		})
+	}
+	return params
+}
+
+// isExported 檢查名稱是否以大寫字母開頭
+func isExported(name string) bool {
+	return name != "" && name[0] >= 'A' && name[0] <= 'Z'
+}
+
+// removeString 從切片中移除指定的字符串
+func removeString(list []string, s string) []string {
+	for i, v := range list {
+		if v == s {
+			return append(list[:i], list[i+1:]...)
+		}
+	}
+	return list
+}
@@ -1,11 +1,61 @@
 package api
 
 import (
+	"backend/internal/api/gql"
 	"backend/internal/api/handlers"
+	"backend/internal/feature"
 
 	"github.com/gin-gonic/gin"
 )
 
 func SetupRoutes(r *gin.Engine) {
-	r.GET("/api/server-status", handlers.GetServerStatus)
+	registerRoutes(r.Group("/api/v1", handlers.UseEnvelope()))
+
+	// 舊版路由保持原有的回應格式，作為 /api/v1 的已棄用別名
+	registerRoutes(r.Group("/api", handlers.Deprecated("/api", "/api/v1")))
+
+	r.GET("/api/openapi.json", handlers.GetOpenAPISpec)
+	r.GET("/api/docs", handlers.GetAPIDocs)
+	r.GET("/api/badge/:address", handlers.GetBadge)
+	r.GET("/api/banner/:address", handlers.GetBanner)
+	r.GET("/api/avatar/:uuid", handlers.GetPlayerAvatar)
+	r.GET("/widget/:address", handlers.GetWidget)
+
+	compat := r.Group("/api/compat", handlers.RequireFeature(feature.Compat))
+
+	// 與 mcsrvstat.us v2 API 相同格式的兼容路由
+	compat.GET("/mcsrvstat/2/:address", handlers.GetMcsrvstatJava)
+	compat.GET("/mcsrvstat/bedrock/2/:address", handlers.GetMcsrvstatBedrock)
+
+	// 與 mcstatus.io v2 API 相同格式的兼容路由
+	compat.GET("/mcstatusio/v2/status/java/:address", handlers.GetMcstatusioJava)
+	compat.GET("/mcstatusio/v2/status/bedrock/:address", handlers.GetMcstatusioBedrock)
+
+	graphqlHandler := gql.Handler()
+	serveGraphQL := func(c *gin.Context) {
+		ctx := gql.WithClient(c.Request.Context(), c.ClientIP())
+		graphqlHandler.ServeHTTP(c.Writer, c.Request.WithContext(ctx))
+	}
+	r.GET("/graphql", serveGraphQL)
+	r.POST("/graphql", serveGraphQL)
+}
+
+// registerRoutes 在指定的路由組下註冊所有 API 路由
+func registerRoutes(g *gin.RouterGroup) {
+	for _, e := range handlers.Endpoints {
+		g.GET(e.Path, e.Handler)
+	}
+
+	// 管理員專用的路由，不列入 OpenAPI 文檔
+	admin := g.Group("/admin", handlers.RequireAdmin())
+	admin.GET("/cache", handlers.GetCacheEntries)
+	admin.DELETE("/cache", handlers.FlushCache)
+	admin.DELETE("/cache/:address", handlers.DeleteCacheEntry)
+	admin.GET("/inflight", handlers.GetInflightQueries)
+	admin.GET("/targets", handlers.GetTargetStats)
+	admin.GET("/metrics", handlers.GetMetrics)
+	admin.GET("/features", handlers.GetFeatures)
+	admin.PUT("/features/:name", handlers.SetFeature)
+	admin.GET("/debug/stats", handlers.GetDebugStats)
+	admin.GET("/debug/pprof/*name", handlers.GetProfile)
 }
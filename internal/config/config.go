@@ -0,0 +1,167 @@
+// Package config 負責從環境變量讀取應用程式設定
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Config 定義了應用程式的所有設定項
+type Config struct {
+	Port                 string        // 伺服器監聽的端口
+	GinMode              string        // Gin 的運行模式
+	MaxConnsPerHost      int           // 對同一目標伺服器的最大同時連接數（0 表示不限制）
+	DNSCacheTTL          time.Duration // DNS 解析結果的默認緩存時間（0 表示不緩存）
+	DNSServers           []string      // 自定義 DNS 伺服器列表（為空時使用系統解析器）
+	DoHEndpoint          string        // DNS-over-HTTPS 端點（優先於 DNSServers）
+	SOCKS5Proxy          string        // 出站查詢使用的 SOCKS5 代理（為空時直接連接）
+	BindAddress          string        // 出站連接綁定的本地 IP 或網卡名稱（為空時由系統選擇）
+	AdminToken           string        // 管理員令牌（為空時停用所有管理員功能）
+	GRPCPort             string        // gRPC 服務監聽的端口（為空時不啟動 gRPC 服務）
+	ServeFrontend        bool          // 是否在 / 上提供內置的儀表板
+	FrontendServers      []string      // 儀表板預設顯示的伺服器列表
+	GeoIPDatabase        string        // MaxMind GeoLite2 City 數據庫的路徑（為空時不查詢地理位置）
+	ASNDatabase          string        // MaxMind GeoLite2 ASN 數據庫的路徑（為空時不查詢 ASN）
+	StatusCacheTTL       time.Duration // 查詢結果的緩存時間（0 表示不緩存）
+	StaleWhileRevalidate time.Duration // 緩存過期後仍直接返回舊結果並在後台刷新的時間
+	StaleIfError         time.Duration // 緩存過期後查詢失敗時仍可返回舊結果的時間
+	PrewarmServers       []string      // 定期查詢以保持緩存新鮮的伺服器，格式為 "地址" 或 "版本=地址"
+	RefreshAdminOnly     bool          // 是否只允許管理員通過 refresh 參數強制刷新緩存
+	Region               string        // 本實例所在的區域名稱
+	ProbeRegions         []string      // 其他區域的實例，格式為 "名稱=地址"
+	SlowQueryThreshold   time.Duration // 記錄慢查詢的耗時閾值（0 表示不記錄）
+	CompressResponses    bool          // 是否以 gzip 壓縮文本類型的回應
+	TLSDomains           []string      // 通過 Let's Encrypt 自動申請證書的域名（為空時不啟用 HTTPS）
+	TLSPort              string        // HTTPS 服務監聽的端口
+	TLSCacheDir          string        // 保存自動申請的證書的目錄
+	TLSEmail             string        // 向 Let's Encrypt 註冊時使用的聯繫郵箱
+	HTTP2Cleartext       bool          // 是否在 HTTP 端口上接受不加密的 HTTP/2（h2c）連接
+	UnixSocket           string        // 代替 TCP 端口監聽的 Unix 套接字路徑（為空時監聽 TCP 端口）
+	UnixSocketMode       os.FileMode   // Unix 套接字文件的權限
+	MockJavaAddress      string        // --mock 模式下模擬 Java 版伺服器監聽的 TCP 地址
+	MockBedrockAddress   string        // --mock 模式下模擬基岩版伺服器監聽的 UDP 地址
+	FeatureFlags         []string      // 功能開關的初始狀態，格式為 "名稱=true" 或 "名稱=false"
+	Enrichers            []string      // 外部 HTTP 擴展，格式為 "名稱=地址"
+	EnricherTimeout      time.Duration // 等待所有擴展的時限
+}
+
+// Load 從環境變量讀取設定，未設置的項目使用默認值
+func Load() *Config {
+	return &Config{
+		Port:                 getEnv("PORT", "8080"),
+		GinMode:              getEnv("GIN_MODE", gin.ReleaseMode),
+		MaxConnsPerHost:      getEnvInt("MC_MAX_CONNS_PER_HOST", 2),
+		DNSCacheTTL:          getEnvDuration("MC_DNS_CACHE_TTL", time.Minute),
+		DNSServers:           getEnvList("MC_DNS_SERVERS"),
+		DoHEndpoint:          getEnv("MC_DOH_URL", ""),
+		SOCKS5Proxy:          getEnv("MC_SOCKS5_PROXY", ""),
+		BindAddress:          getEnv("MC_BIND_ADDRESS", ""),
+		AdminToken:           getEnv("ADMIN_TOKEN", ""),
+		GRPCPort:             getEnv("GRPC_PORT", ""),
+		ServeFrontend:        getEnvBool("SERVE_FRONTEND", false),
+		FrontendServers:      getEnvList("FRONTEND_SERVERS"),
+		GeoIPDatabase:        getEnv("GEOIP_DB", ""),
+		ASNDatabase:          getEnv("GEOIP_ASN_DB", ""),
+		StatusCacheTTL:       getEnvDuration("STATUS_CACHE_TTL", 0),
+		StaleWhileRevalidate: getEnvDuration("STATUS_CACHE_STALE_WHILE_REVALIDATE", time.Minute),
+		StaleIfError:         getEnvDuration("STATUS_CACHE_STALE_IF_ERROR", time.Hour),
+		PrewarmServers:       getEnvList("STATUS_CACHE_PREWARM"),
+		RefreshAdminOnly:     getEnvBool("STATUS_REFRESH_ADMIN_ONLY", false),
+		Region:               getEnv("REGION", "local"),
+		ProbeRegions:         getEnvList("PROBE_REGIONS"),
+		SlowQueryThreshold:   getEnvDuration("SLOW_QUERY_THRESHOLD", 3*time.Second),
+		CompressResponses:    getEnvBool("COMPRESS_RESPONSES", true),
+		TLSDomains:           getEnvList("TLS_DOMAINS"),
+		TLSPort:              getEnv("TLS_PORT", "443"),
+		TLSCacheDir:          getEnv("TLS_CACHE_DIR", "certs"),
+		TLSEmail:             getEnv("TLS_EMAIL", ""),
+		HTTP2Cleartext:       getEnvBool("HTTP2_CLEARTEXT", false),
+		UnixSocket:           getEnv("UNIX_SOCKET", ""),
+		UnixSocketMode:       getEnvFileMode("UNIX_SOCKET_MODE", 0o660),
+		MockJavaAddress:      getEnv("MOCK_JAVA_ADDRESS", "127.0.0.1:25565"),
+		MockBedrockAddress:   getEnv("MOCK_BEDROCK_ADDRESS", "127.0.0.1:19132"),
+		FeatureFlags:         getEnvList("FEATURE_FLAGS"),
+		Enrichers:            getEnvList("ENRICHERS"),
+		EnricherTimeout:      getEnvDuration("ENRICHER_TIMEOUT", 2*time.Second),
+	}
+}
+
+// getEnv 讀取字符串類型的環境變量
+func getEnv(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+// getEnvInt 讀取整數類型的環境變量，格式錯誤時使用默認值
+func getEnvInt(key string, def int) int {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		log.Printf("環境變量 %s 的值無效 (%q)，使用默認值 %d", key, v, def)
+		return def
+	}
+	return n
+}
+
+// getEnvBool 讀取布爾類型的環境變量（例如 "true"、"1"），格式錯誤時使用默認值
+func getEnvBool(key string, def bool) bool {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		log.Printf("環境變量 %s 的值無效 (%q)，使用默認值 %v", key, v, def)
+		return def
+	}
+	return b
+}
+
+// getEnvList 讀取以逗號分隔的列表類型環境變量，忽略空白項
+func getEnvList(key string) []string {
+	var list []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// getEnvDuration 讀取時間長度類型的環境變量（例如 "30s"、"5m"），格式錯誤時使用默認值
+func getEnvDuration(key string, def time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		log.Printf("環境變量 %s 的值無效 (%q)，使用默認值 %s", key, v, def)
+		return def
+	}
+	return d
+}
+
+// getEnvFileMode 讀取八進制文件權限類型的環境變量（例如 "0660"），格式錯誤時使用默認值
+func getEnvFileMode(key string, def os.FileMode) os.FileMode {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	mode, err := strconv.ParseUint(v, 8, 32)
+	if err != nil || mode > 0o777 {
+		log.Printf("環境變量 %s 的值無效 (%q)，使用默認值 %#o", key, v, def)
+		return def
+	}
+	return os.FileMode(mode)
+}
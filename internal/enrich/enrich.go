@@ -0,0 +1,170 @@
+// Package enrich 讓部署者在狀態回應中附帶自定義字段，例如內部監控 ID 或付費等級
+// 擴展可以是在 main 中通過 Register 註冊的 Go 代碼，也可以是通過 ENRICHERS 設置的外部 HTTP 服務
+package enrich
+
+import (
+	"backend/mcstatus"
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTimeout 是等待所有擴展的默認時限
+const DefaultTimeout = 2 * time.Second
+
+// Enricher 為查詢結果生成附加數據，返回的值會以擴展名稱為鍵放在回應的 extensions 字段中
+// 返回 nil 時不附帶該擴展的數據；返回錯誤時只記錄日誌，不影響回應
+// 多個擴展會同時調用，實現不應修改 status，並應在 ctx 結束時儘快返回
+type Enricher interface {
+	Enrich(ctx context.Context, address string, status *mcstatus.EditionStatus) (any, error)
+}
+
+// Func 將普通函數轉換為 Enricher
+type Func func(ctx context.Context, address string, status *mcstatus.EditionStatus) (any, error)
+
+// Enrich 調用 f
+func (f Func) Enrich(ctx context.Context, address string, status *mcstatus.EditionStatus) (any, error) {
+	return f(ctx, address, status)
+}
+
+// entry 是已註冊的擴展
+type entry struct {
+	name     string
+	enricher Enricher
+}
+
+var (
+	mu        sync.RWMutex
+	enrichers []entry
+	timeout   = DefaultTimeout
+)
+
+// Register 以指定名稱註冊擴展，名稱為空或重複時 panic
+// 應在啟動 HTTP 服務之前調用
+func Register(name string, e Enricher) {
+	if name == "" || e == nil {
+		panic("enrich: 擴展名稱和實現不能為空")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	for _, existing := range enrichers {
+		if existing.name == name {
+			panic("enrich: 重複註冊的擴展 " + name)
+		}
+	}
+	enrichers = append(enrichers, entry{name: name, enricher: e})
+}
+
+// SetTimeout 設置等待所有擴展的時限，d <= 0 時使用 DefaultTimeout
+func SetTimeout(d time.Duration) {
+	if d <= 0 {
+		d = DefaultTimeout
+	}
+	mu.Lock()
+	timeout = d
+	mu.Unlock()
+}
+
+// Configure 註冊外部 HTTP 擴展，每一項格式為 "名稱=地址"，例如 "billing=http://billing.internal/enrich"
+func Configure(settings []string) error {
+	for _, s := range settings {
+		name, endpoint, ok := strings.Cut(s, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return fmt.Errorf("無效的擴展設定: %q", s)
+		}
+		if u, err := url.Parse(endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("無效的擴展地址: %q", endpoint)
+		}
+		if registered(name) {
+			return fmt.Errorf("重複的擴展名稱: %s", name)
+		}
+		Register(name, NewHTTP(endpoint))
+	}
+	return nil
+}
+
+// registered 返回是否已經註冊了指定名稱的擴展
+func registered(name string) bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	for _, e := range enrichers {
+		if e.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Names 返回所有已註冊擴展的名稱
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, len(enrichers))
+	for i, e := range enrichers {
+		names[i] = e.name
+	}
+	return names
+}
+
+// Apply 同時調用所有擴展，返回以擴展名稱為鍵的附加數據，沒有任何數據時返回 nil
+// 超時、出錯或返回 nil 的擴展不附帶數據
+// 超時返回後仍在運行的擴展可能繼續讀取 status，調用者應傳入之後不會再修改的副本（例如 EditionStatus.Clone 的結果）
+func Apply(ctx context.Context, address string, status *mcstatus.EditionStatus) map[string]any {
+	mu.RLock()
+	list, d := enrichers, timeout
+	mu.RUnlock()
+	if len(list) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d)
+	defer cancel()
+
+	var (
+		resultMu   sync.Mutex
+		extensions map[string]any
+		finished   bool // 超時後仍未返回的擴展的結果會被丟棄
+		wg         sync.WaitGroup
+	)
+	for _, e := range list {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := e.enricher.Enrich(ctx, address, status)
+			if err != nil {
+				log.Printf("擴展 %s 處理 %s 失敗: %v", e.name, address, err)
+				return
+			}
+			resultMu.Lock()
+			defer resultMu.Unlock()
+			if v == nil || finished {
+				return
+			}
+			if extensions == nil {
+				extensions = make(map[string]any)
+			}
+			extensions[e.name] = v
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		log.Printf("等待擴展處理 %s 超時", address)
+	}
+
+	resultMu.Lock()
+	defer resultMu.Unlock()
+	finished = true
+	return extensions
+}
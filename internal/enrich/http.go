@@ -0,0 +1,80 @@
+package enrich
+
+import (
+	"backend/mcstatus"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// maxHTTPResponseSize 是外部擴展回應的最大長度
+const maxHTTPResponseSize = 64 << 10
+
+// httpClient 是調用外部擴展使用的 HTTP 客戶端，時限由 Apply 的 ctx 控制
+var httpClient = &http.Client{}
+
+// httpRequest 是發送給外部擴展的請求內容
+type httpRequest struct {
+	Address string                  `json:"address"`
+	Edition string                  `json:"edition"`
+	Java    *mcstatus.ServerStatus  `json:"java,omitempty"`
+	Bedrock *mcstatus.BedrockStatus `json:"bedrock,omitempty"`
+}
+
+// httpEnricher 通過 HTTP 調用外部擴展
+type httpEnricher struct {
+	url string
+}
+
+// NewHTTP 返回調用外部 HTTP 服務的擴展
+// 每次查詢以 POST 發送包含地址、版本和查詢結果的 JSON，回應內容（必須是 JSON）原樣附帶在回應中；
+// 服務返回 204 時不附帶數據
+func NewHTTP(url string) Enricher {
+	return &httpEnricher{url: url}
+}
+
+// Enrich 調用外部擴展
+func (h *httpEnricher) Enrich(ctx context.Context, address string, status *mcstatus.EditionStatus) (any, error) {
+	body, err := json.Marshal(httpRequest{
+		Address: address,
+		Edition: status.Edition,
+		Java:    status.Java,
+		Bedrock: status.Bedrock,
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("擴展返回了狀態碼 %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxHTTPResponseSize {
+		return nil, errors.New("擴展的回應過長")
+	}
+	if !json.Valid(data) {
+		return nil, errors.New("擴展的回應不是有效的 JSON")
+	}
+	return json.RawMessage(data), nil
+}
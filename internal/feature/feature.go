@@ -0,0 +1,158 @@
+// Package feature 提供可在運行時切換的功能開關，讓有風險或實驗性的功能可以先以停用狀態部署
+package feature
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Flag 是功能開關的名稱
+type Flag string
+
+// 可切換的功能
+const (
+	Bedrock    Flag = "bedrock"     // 基岩版查詢
+	LoginCheck Flag = "login_check" // 狀態查詢的 login_check 參數
+	Compat     Flag = "compat"      // 與 mcsrvstat.us 和 mcstatus.io 格式相同的兼容路由
+)
+
+// ErrUnknownFlag 表示功能開關不存在
+var ErrUnknownFlag = errors.New("未知的功能開關")
+
+// State 是功能開關的當前狀態
+type State struct {
+	Name        Flag
+	Description string
+	Enabled     bool // 當前是否啟用
+	Default     bool // 啟動時的狀態，即默認值或 FEATURE_FLAGS 中的設定
+}
+
+// flag 是單個功能開關
+type flag struct {
+	name        Flag
+	description string
+	initial     bool
+	enabled     atomic.Bool
+	watchers    []func(enabled bool)
+}
+
+// mu 保護 initial 和 watchers，並保證開關變化按順序通知
+var mu sync.Mutex
+
+// flags 是所有功能開關，按列出的順序返回；目前所有功能默認啟用
+var flags = []*flag{
+	{name: Bedrock, description: "查詢基岩版伺服器，停用時自動判斷版本只查詢 Java 版", initial: true},
+	{name: LoginCheck, description: "狀態查詢的 login_check 參數", initial: true},
+	{name: Compat, description: "與 mcsrvstat.us 和 mcstatus.io 格式相同的兼容路由", initial: true},
+}
+
+func init() {
+	for _, f := range flags {
+		f.enabled.Store(f.initial)
+	}
+}
+
+// lookup 返回指定名稱的功能開關，不存在時返回 nil
+func lookup(name Flag) *flag {
+	for _, f := range flags {
+		if f.name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// Enabled 返回功能是否啟用，不存在的功能視為停用
+func Enabled(name Flag) bool {
+	f := lookup(name)
+	return f != nil && f.enabled.Load()
+}
+
+// Set 啟用或停用功能，並通知通過 Watch 註冊的函數
+// 變化只保存在內存中，重新啟動後恢復 FEATURE_FLAGS 中的設定
+func Set(name Flag, enabled bool) error {
+	f := lookup(name)
+	if f == nil {
+		return fmt.Errorf("%w: %s", ErrUnknownFlag, name)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if f.enabled.Swap(enabled) == enabled {
+		return nil
+	}
+	log.Printf("功能開關 %s 已%s", name, stateText(enabled))
+	for _, fn := range f.watchers {
+		fn(enabled)
+	}
+	return nil
+}
+
+// Watch 立即以功能的當前狀態調用 fn，之後每次狀態變化時再次調用，
+// 用於將開關同步到不能直接讀取開關的包（例如 mcstatus）
+func Watch(name Flag, fn func(enabled bool)) {
+	f := lookup(name)
+	if f == nil {
+		return
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	f.watchers = append(f.watchers, fn)
+	fn(f.enabled.Load())
+}
+
+// Configure 根據 "名稱=true" 或 "名稱=false" 格式的設定設置功能的初始狀態
+func Configure(settings []string) error {
+	for _, s := range settings {
+		name, value, ok := strings.Cut(s, "=")
+		if !ok {
+			return fmt.Errorf("無效的功能開關設定 %q，格式應為 名稱=true 或 名稱=false", s)
+		}
+		enabled, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("無效的功能開關設定 %q: %w", s, err)
+		}
+		f := lookup(Flag(strings.TrimSpace(name)))
+		if f == nil {
+			return fmt.Errorf("%w: %s", ErrUnknownFlag, strings.TrimSpace(name))
+		}
+
+		mu.Lock()
+		f.initial = enabled
+		mu.Unlock()
+		if err := Set(f.name, enabled); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// List 返回所有功能開關的當前狀態
+func List() []State {
+	mu.Lock()
+	defer mu.Unlock()
+	states := make([]State, len(flags))
+	for i, f := range flags {
+		states[i] = State{
+			Name:        f.name,
+			Description: f.description,
+			Enabled:     f.enabled.Load(),
+			Default:     f.initial,
+		}
+	}
+	return states
+}
+
+// stateText 返回日誌中使用的狀態描述
+func stateText(enabled bool) string {
+	if enabled {
+		return "啟用"
+	}
+	return "停用"
+}
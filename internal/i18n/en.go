@@ -0,0 +1,61 @@
+package i18n
+
+// 英文信息目錄
+func init() {
+	Register("en", map[string]string{
+		// 請求參數錯誤
+		"伺服器地址不能為空":             "server address is required",
+		"無法連接到區域 %s 的實例":        "failed to reach the instance in region %s",
+		"區域 %s 的實例返回了無效的回應":     "the instance in region %s returned an invalid response",
+		"無效的請求參數: %v":           "invalid request parameter: %v",
+		"不能為空":                  "must not be empty",
+		"必須為以下值之一: %s":          "must be one of: %s",
+		"長度不能超過 %d 個字符":         "must be at most %d characters long",
+		"包含無效的字符":               "contains invalid characters",
+		"不能小於 %v":               "must be at least %v",
+		"不能大於 %v":               "must be at most %v",
+		"無效的基岩版端口: %s":          "invalid Bedrock port: %s",
+		"無效的回調函數名稱: %s":         "invalid callback name: %s",
+		"單次最多查詢 %d 個伺服器":        "at most %d servers can be queried at once",
+		"不支援的圖片格式: %s":          "unsupported image format: %s",
+		"頭像尺寸必須是 8 到 512 之間的整數": "avatar size must be an integer between 8 and 512",
+		"只有管理員可以指定出站地址":         "only administrators may set the outbound address",
+		"只有管理員可以使用調試模式":         "only administrators may use debug mode",
+		"只有管理員可以強制刷新緩存":         "only administrators may force a cache refresh",
+		"需要管理員權限":               "administrator privileges required",
+		"處理請求時發生內部錯誤":           "an internal error occurred while handling the request",
+
+		// 查詢錯誤
+		"無效的端口":            "invalid port",
+		"無效的伺服器地址":         "invalid server address",
+		"無效的 IPv6 地址":      "invalid IPv6 address",
+		"無效的主機名":           "invalid hostname",
+		"不支援的地址協議":         "unsupported address scheme",
+		"設置出站連接失敗":         "failed to set up outbound connection",
+		"無法找到 IP 地址":       "no IP address found",
+		"無法解析主機名":          "failed to resolve hostname",
+		"連接伺服器失敗":          "failed to connect to server",
+		"發送握手數據包失敗":        "failed to send handshake packet",
+		"發送狀態請求數據包失敗":      "failed to send status request packet",
+		"讀取和解析回應失敗":        "failed to read and parse response",
+		"解析 JSON 響應失敗":     "failed to parse JSON response",
+		"發送 Ping 數據包失敗":    "failed to send ping packet",
+		"讀取回應失敗":           "failed to read response",
+		"解析 Pong 數據包失敗":    "failed to parse pong packet",
+		"查詢基岩版伺服器失敗":       "Bedrock query failed",
+		"Java 版和基岩版查詢均失敗":  "both Java and Bedrock queries failed",
+		"伺服器沒有設置圖標":        "server has no favicon",
+		"解碼伺服器圖標失敗":        "failed to decode server favicon",
+		"解析伺服器圖標失敗":        "failed to parse server favicon",
+		"不是正版玩家的 UUID":     "not a premium player UUID",
+		"獲取玩家資料失敗":         "failed to fetch player profile",
+		"玩家不存在":            "player not found",
+		"玩家沒有自定義皮膚":        "player has no custom skin",
+		"下載玩家皮膚失敗":         "failed to download player skin",
+		"基岩版查詢已停用":         "Bedrock queries are disabled",
+		"對該伺服器的查詢過多，請稍後再試": "too many queries to this server, try again later",
+		"等待連接名額超時":         "timed out waiting for a connection slot",
+		"功能已停用: %s":        "feature disabled: %s",
+		"未知的功能開關: %s":      "unknown feature flag: %s",
+	})
+}
@@ -0,0 +1,94 @@
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultLanguage 是源語言，所有信息都以繁體中文撰寫，其他語言的目錄以中文原文為鍵
+const DefaultLanguage = "zh"
+
+var (
+	mu       sync.RWMutex
+	catalogs = map[string]map[string]string{
+		DefaultLanguage: {},
+	}
+)
+
+// Register 註冊一個語言的信息目錄，已存在的翻譯會被覆蓋
+// 鍵是中文原文，值是對應語言的翻譯
+func Register(lang string, messages map[string]string) {
+	lang = strings.ToLower(lang)
+
+	mu.Lock()
+	defer mu.Unlock()
+	catalog, ok := catalogs[lang]
+	if !ok {
+		catalog = make(map[string]string, len(messages))
+		catalogs[lang] = catalog
+	}
+	for k, v := range messages {
+		catalog[k] = v
+	}
+}
+
+// Supported 檢查是否支援指定語言
+func Supported(lang string) bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	_, ok := catalogs[strings.ToLower(lang)]
+	return ok
+}
+
+// Translate 返回信息在指定語言中的翻譯，沒有翻譯時返回原文
+func Translate(lang, message string) string {
+	mu.RLock()
+	defer mu.RUnlock()
+	if translated, ok := catalogs[lang][message]; ok {
+		return translated
+	}
+	return message
+}
+
+// Negotiate 根據 Accept-Language 標頭選擇最合適的已支援語言
+// 只比較主語言標籤（如 en-US 和 en-GB 都對應 en），沒有匹配時返回 DefaultLanguage
+func Negotiate(acceptLanguage string) string {
+	type candidate struct {
+		lang string
+		q    float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" {
+			continue
+		}
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+		primary, _, _ := strings.Cut(tag, "-")
+		candidates = append(candidates, candidate{lang: strings.ToLower(primary), q: q})
+	}
+
+	// 權重相同時保持標頭中的順序
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+	for _, c := range candidates {
+		if Supported(c.lang) {
+			return c.lang
+		}
+	}
+	return DefaultLanguage
+}
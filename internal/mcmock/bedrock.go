@@ -0,0 +1,49 @@
+package mcmock
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"net"
+)
+
+// raknetMagic 是 RakNet 離線消息中固定的魔數
+var raknetMagic = []byte{0x00, 0xff, 0xff, 0x00, 0xfe, 0xfe, 0xfe, 0xfe, 0xfd, 0xfd, 0xfd, 0xfd, 0x12, 0x34, 0x56, 0x78}
+
+// RakNet 離線消息的 ID
+const (
+	unconnectedPingID = 0x01
+	unconnectedPongID = 0x1c
+)
+
+// serverGUID 是 Pong 數據包中的伺服器 GUID
+const serverGUID = 0x4d6f636b53657276
+
+// serveBedrock 回應基岩版的 Unconnected Ping
+func (s *Server) serveBedrock(conn net.PacketConn) {
+	defer s.wg.Done()
+	buf := make([]byte, 1500)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				logf("讀取基岩版 Ping 失敗: %v", err)
+			}
+			return
+		}
+		// Ping 格式：ID(1) + 時間(8) + 魔數(16) + 客戶端 GUID(8)
+		ping := buf[:n]
+		if n < 1+8+16 || ping[0] != unconnectedPingID || !bytes.Equal(ping[9:25], raknetMagic) {
+			continue
+		}
+
+		var pong bytes.Buffer
+		pong.WriteByte(unconnectedPongID)
+		pong.Write(ping[1:9])
+		binary.Write(&pong, binary.BigEndian, uint64(serverGUID))
+		pong.Write(raknetMagic)
+		binary.Write(&pong, binary.BigEndian, uint16(len(s.Bedrock)))
+		pong.WriteString(s.Bedrock)
+		conn.WriteTo(pong.Bytes(), addr)
+	}
+}
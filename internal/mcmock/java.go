@@ -0,0 +1,131 @@
+package mcmock
+
+import (
+	"backend/mcstatus"
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+// maxPacketLength 是接受的最大數據包長度，狀態查詢的數據包都很小
+const maxPacketLength = 1 << 16
+
+// 握手數據包中的下一個狀態
+const (
+	stateStatus = 1
+	stateLogin  = 2
+)
+
+// serveJava 接受 Java 版客戶端的連接
+func (s *Server) serveJava(ln net.Listener) {
+	defer s.wg.Done()
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				logf("接受連接失敗: %v", err)
+			}
+			return
+		}
+		go s.handleJava(conn)
+	}
+}
+
+// handleJava 處理一個 Java 版連接：握手後返回狀態並回應 Ping，或在登入時斷開連接
+func (s *Server) handleJava(conn net.Conn) {
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(10 * time.Second))
+	r := bufio.NewReader(conn)
+
+	id, payload, err := readPacket(r)
+	if err != nil || id != 0x00 {
+		return
+	}
+	hs := bytes.NewReader(payload)
+	mcstatus.ReadVarInt(hs) // 協議版本
+	if _, err := readString(hs); err != nil {
+		return
+	}
+	hs.Seek(2, io.SeekCurrent) // 端口
+	next, err := mcstatus.ReadVarInt(hs)
+	if err != nil {
+		return
+	}
+
+	switch next {
+	case stateStatus:
+		for {
+			id, payload, err := readPacket(r)
+			if err != nil {
+				return
+			}
+			switch id {
+			case 0x00: // 狀態請求
+				if err := writePacket(conn, 0x00, appendString(nil, string(s.Java))); err != nil {
+					return
+				}
+			case 0x01: // Ping，原樣返回時間戳
+				writePacket(conn, 0x01, payload)
+				return
+			default:
+				return
+			}
+		}
+	case stateLogin:
+		reason, _ := json.Marshal(map[string]string{"text": "這是模擬伺服器，無法登入"})
+		writePacket(conn, 0x00, appendString(nil, string(reason)))
+	}
+}
+
+// readPacket 讀取一個未壓縮的數據包，返回數據包 ID 和內容
+func readPacket(r *bufio.Reader) (int32, []byte, error) {
+	length, err := mcstatus.ReadVarInt(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	if length <= 0 || length > maxPacketLength {
+		return 0, nil, fmt.Errorf("無效的數據包長度: %d", length)
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return 0, nil, err
+	}
+	body := bytes.NewReader(data)
+	id, err := mcstatus.ReadVarInt(body)
+	if err != nil {
+		return 0, nil, err
+	}
+	return id, data[len(data)-body.Len():], nil
+}
+
+// writePacket 寫入一個帶長度前綴的數據包
+func writePacket(w io.Writer, id int32, payload []byte) error {
+	body := mcstatus.AppendVarInt(nil, id)
+	body = append(body, payload...)
+	_, err := w.Write(append(mcstatus.AppendVarInt(nil, int32(len(body))), body...))
+	return err
+}
+
+// readString 讀取一個帶 VarInt 長度前綴的字符串
+func readString(r *bytes.Reader) (string, error) {
+	n, err := mcstatus.ReadVarInt(r)
+	if err != nil {
+		return "", err
+	}
+	if n < 0 || int(n) > r.Len() {
+		return "", errors.New("字符串長度超出數據包")
+	}
+	buf := make([]byte, n)
+	r.Read(buf)
+	return string(buf), nil
+}
+
+// appendString 將帶長度前綴的字符串追加到 buf
+func appendString(buf []byte, s string) []byte {
+	return append(mcstatus.AppendVarInt(buf, int32(len(s))), s...)
+}
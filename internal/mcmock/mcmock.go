@@ -0,0 +1,158 @@
+// Package mcmock 提供了在本地端口上返回固定回應的模擬 Minecraft 伺服器，
+// 只實現查詢狀態所需的協議（Java 版 SLP 和基岩版 RakNet Ping），供集成測試和前端開發使用
+package mcmock
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"log"
+	"net"
+	"sync"
+)
+
+// DefaultBedrockStatus 是默認的基岩版伺服器信息，格式與 Pong 數據包中以分號分隔的字段相同
+const DefaultBedrockStatus = "MCPE;§bMock §fBedrock Server;671;1.20.80;3;20;1234567890123456789;Mock World;Survival;1;19132;19133;"
+
+// DefaultJavaStatus 返回默認的 Java 版狀態回應，包含玩家樣本、彩色 MOTD 及圖標
+func DefaultJavaStatus() []byte {
+	status := map[string]any{
+		"version": map[string]any{"name": "Mock 1.20.4", "protocol": 765},
+		"players": map[string]any{
+			"max":    20,
+			"online": 3,
+			"sample": []map[string]string{
+				{"name": "Steve", "id": "8667ba71-b85a-4004-af54-457a9734eed7"},
+				{"name": "Alex", "id": "ec561538-f3fd-461d-aff5-086b22154bce"},
+			},
+		},
+		"description": map[string]any{
+			"text": "",
+			"extra": []map[string]any{
+				{"text": "Mock ", "color": "gold", "bold": true},
+				{"text": "Minecraft Server\n", "color": "#55ffff"},
+				{"text": "§7本地開發用的模擬伺服器"},
+			},
+		},
+		"favicon":            "data:image/png;base64," + base64.StdEncoding.EncodeToString(defaultIcon()),
+		"enforcesSecureChat": false,
+	}
+	data, _ := json.Marshal(status)
+	return data
+}
+
+// defaultIcon 生成 64x64 的 PNG 圖標
+func defaultIcon() []byte {
+	img := image.NewRGBA(image.Rect(0, 0, 64, 64))
+	for y := 0; y < 64; y++ {
+		for x := 0; x < 64; x++ {
+			c := color.RGBA{0x5d, 0x9c, 0x3f, 0xff} // 草地
+			if y >= 16 {
+				c = color.RGBA{0x86, 0x60, 0x43, 0xff} // 泥土
+			}
+			if (x/8+y/8)%2 == 0 {
+				c.R, c.G, c.B = c.R*7/8, c.G*7/8, c.B*7/8
+			}
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	png.Encode(&buf, img)
+	return buf.Bytes()
+}
+
+// Server 是一個返回固定回應的模擬伺服器，可同時在 TCP 端口上提供 Java 版狀態，在 UDP 端口上回應基岩版 Ping
+type Server struct {
+	// Java 是 Java 版狀態回應的 JSON，為 nil 時使用 DefaultJavaStatus
+	Java []byte
+	// Bedrock 是基岩版的伺服器信息，為空時使用 DefaultBedrockStatus
+	Bedrock string
+
+	mu      sync.Mutex
+	javaLn  net.Listener
+	udpConn net.PacketConn
+	wg      sync.WaitGroup
+}
+
+// Start 開始在指定地址上監聽，javaAddr 或 bedrockAddr 為空時不提供對應的版本
+// 地址的端口可以為 0，實際的地址可以通過 JavaAddr 和 BedrockAddr 取得
+func (s *Server) Start(javaAddr, bedrockAddr string) error {
+	if javaAddr == "" && bedrockAddr == "" {
+		return errors.New("至少需要指定一個監聽地址")
+	}
+	if s.Java == nil {
+		s.Java = DefaultJavaStatus()
+	}
+	if s.Bedrock == "" {
+		s.Bedrock = DefaultBedrockStatus
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if javaAddr != "" {
+		ln, err := net.Listen("tcp", javaAddr)
+		if err != nil {
+			return err
+		}
+		s.javaLn = ln
+		s.wg.Add(1)
+		go s.serveJava(ln)
+	}
+	if bedrockAddr != "" {
+		conn, err := net.ListenPacket("udp", bedrockAddr)
+		if err != nil {
+			if s.javaLn != nil {
+				s.javaLn.Close()
+			}
+			return err
+		}
+		s.udpConn = conn
+		s.wg.Add(1)
+		go s.serveBedrock(conn)
+	}
+	return nil
+}
+
+// JavaAddr 返回 Java 版實際監聽的地址，未監聽時返回空字符串
+func (s *Server) JavaAddr() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.javaLn == nil {
+		return ""
+	}
+	return s.javaLn.Addr().String()
+}
+
+// BedrockAddr 返回基岩版實際監聽的地址，未監聽時返回空字符串
+func (s *Server) BedrockAddr() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.udpConn == nil {
+		return ""
+	}
+	return s.udpConn.LocalAddr().String()
+}
+
+// Close 停止監聽並等待所有連接處理完畢
+func (s *Server) Close() error {
+	s.mu.Lock()
+	var errs []error
+	if s.javaLn != nil {
+		errs = append(errs, s.javaLn.Close())
+	}
+	if s.udpConn != nil {
+		errs = append(errs, s.udpConn.Close())
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+	return errors.Join(errs...)
+}
+
+// logf 記錄模擬伺服器的日誌
+func logf(format string, args ...any) {
+	log.Printf("[mcmock] "+format, args...)
+}
@@ -0,0 +1,58 @@
+package mcmock_test
+
+import (
+	"backend/internal/mcmock"
+	"backend/mcstatus"
+	"testing"
+)
+
+func TestJavaRoundTrip(t *testing.T) {
+	s := &mcmock.Server{}
+	if err := s.Start("127.0.0.1:0", ""); err != nil {
+		t.Fatalf("啟動模擬伺服器失敗: %v", err)
+	}
+	defer s.Close()
+
+	status, err := mcstatus.GetServerStatus(s.JavaAddr(), mcstatus.QueryOptions{})
+	if err != nil {
+		t.Fatalf("查詢模擬伺服器失敗: %v", err)
+	}
+	if status.Version.Name != "Mock 1.20.4" || status.Version.Protocol != 765 {
+		t.Errorf("版本為 %q（協議 %d），應為 \"Mock 1.20.4\"（協議 765）", status.Version.Name, status.Version.Protocol)
+	}
+	if status.Players.Online != 3 || status.Players.Max != 20 {
+		t.Errorf("玩家人數為 %d/%d，應為 3/20", status.Players.Online, status.Players.Max)
+	}
+	if len(status.Players.Sample) != 2 || status.Players.Sample[0].Name != "Steve" {
+		t.Errorf("玩家樣本為 %+v，應包含 Steve 和 Alex", status.Players.Sample)
+	}
+	want := "Mock Minecraft Server\n本地開發用的模擬伺服器"
+	if got := mcstatus.RenderPlainText(status.Description.Components); got != want {
+		t.Errorf("MOTD 為 %q，應為 %q", got, want)
+	}
+	if status.IconHash == "" || status.FaviconError != "" {
+		t.Errorf("圖標驗證失敗: %s", status.FaviconError)
+	}
+}
+
+func TestBedrockRoundTrip(t *testing.T) {
+	s := &mcmock.Server{}
+	if err := s.Start("", "127.0.0.1:0"); err != nil {
+		t.Fatalf("啟動模擬伺服器失敗: %v", err)
+	}
+	defer s.Close()
+
+	status, err := mcstatus.GetBedrockStatus(s.BedrockAddr(), mcstatus.QueryOptions{})
+	if err != nil {
+		t.Fatalf("查詢模擬伺服器失敗: %v", err)
+	}
+	if status.GameType != "MCPE" || status.Version != "1.20.80" || status.Protocol != 671 {
+		t.Errorf("伺服器信息為 %s %s（協議 %d），應為 MCPE 1.20.80（協議 671）", status.GameType, status.Version, status.Protocol)
+	}
+	if status.Players.Online != 3 || status.Players.Max != 20 {
+		t.Errorf("玩家人數為 %d/%d，應為 3/20", status.Players.Online, status.Players.Max)
+	}
+	if status.MapName != "Mock World" {
+		t.Errorf("地圖名稱為 %q，應為 \"Mock World\"", status.MapName)
+	}
+}
@@ -0,0 +1,87 @@
+package rpc
+
+import (
+	pb "backend/internal/rpc/mcstatuspb"
+	"backend/mcstatus"
+)
+
+// StatusProto 將查詢結果轉換為 protobuf 消息，供 REST API 以 Protobuf 格式返回
+func StatusProto(s *mcstatus.EditionStatus) *pb.ServerStatus {
+	if s.Bedrock != nil {
+		return &pb.ServerStatus{Status: &pb.ServerStatus_Bedrock{Bedrock: bedrockStatus(s.Bedrock)}}
+	}
+	return &pb.ServerStatus{Status: &pb.ServerStatus_Java{Java: javaStatus(s.Java)}}
+}
+
+// javaStatus 將 Java 版伺服器狀態轉換為 protobuf 消息
+func javaStatus(s *mcstatus.ServerStatus) *pb.JavaStatus {
+	out := &pb.JavaStatus{
+		Version: &pb.JavaStatus_Version{
+			Name:     s.Version.Name,
+			Protocol: int32(s.Version.Protocol),
+		},
+		Players: &pb.JavaStatus_Players{
+			Online: int32(s.Players.Online),
+			Max:    int32(s.Players.Max),
+		},
+		Description:         mcstatus.RenderPlainText(s.Description.Components),
+		MotdComponents:      motdComponents(s.Description.Components),
+		Favicon:             s.Favicon,
+		IconHash:            s.IconHash,
+		CompatibleVersions:  s.CompatibleVersions,
+		EnforcesSecureChat:  s.EnforcesSecureChat,
+		PreventsChatReports: s.PreventsChatReports,
+	}
+	for _, p := range s.Players.Sample {
+		out.Players.Sample = append(out.Players.Sample, &pb.JavaStatus_Player{Name: p.Name, Id: p.ID})
+	}
+	if s.Software != nil {
+		out.Software = &pb.Software{Name: s.Software.Name, Proxy: s.Software.Proxy}
+	}
+	if s.Forge != nil {
+		out.Forge = &pb.ForgeInfo{
+			Type:              s.Forge.Type,
+			FmlNetworkVersion: int32(s.Forge.FMLNetworkVersion),
+			Truncated:         s.Forge.Truncated,
+		}
+		for _, m := range s.Forge.Mods {
+			out.Forge.Mods = append(out.Forge.Mods, &pb.ForgeMod{Id: m.ID, Version: m.Version, ServerOnly: m.ServerOnly})
+		}
+	}
+	return out
+}
+
+// bedrockStatus 將基岩版伺服器狀態轉換為 protobuf 消息
+func bedrockStatus(s *mcstatus.BedrockStatus) *pb.BedrockStatus {
+	return &pb.BedrockStatus{
+		GameType:       s.GameType,
+		Motd:           s.MOTD,
+		MotdComponents: motdComponents(s.MOTDComponents),
+		Protocol:       int32(s.Protocol),
+		Version:        s.Version,
+		PlayersOnline:  int32(s.Players.Online),
+		PlayersMax:     int32(s.Players.Max),
+		ServerId:       s.ServerID,
+		MapName:        s.MapName,
+		Gamemode:       s.GameMode,
+		PortIpv4:       int32(s.PortIPv4),
+		PortIpv6:       int32(s.PortIPv6),
+	}
+}
+
+// motdComponents 將 MOTD 文本片段轉換為 protobuf 消息
+func motdComponents(components []mcstatus.MOTDComponent) []*pb.MOTDComponent {
+	out := make([]*pb.MOTDComponent, len(components))
+	for i, c := range components {
+		out[i] = &pb.MOTDComponent{
+			Text:          c.Text,
+			Color:         c.Color,
+			Bold:          c.Bold,
+			Italic:        c.Italic,
+			Underlined:    c.Underlined,
+			Strikethrough: c.Strikethrough,
+			Obfuscated:    c.Obfuscated,
+		}
+	}
+	return out
+}
@@ -0,0 +1,1843 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.27.1
+// source: mcstatus/v1/mcstatus.proto
+
+package mcstatuspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Edition 是伺服器版本
+type Edition int32
+
+const (
+	// 依次嘗試 Java 版和基岩版
+	Edition_EDITION_AUTO    Edition = 0
+	Edition_EDITION_JAVA    Edition = 1
+	Edition_EDITION_BEDROCK Edition = 2
+)
+
+// Enum value maps for Edition.
+var (
+	Edition_name = map[int32]string{
+		0: "EDITION_AUTO",
+		1: "EDITION_JAVA",
+		2: "EDITION_BEDROCK",
+	}
+	Edition_value = map[string]int32{
+		"EDITION_AUTO":    0,
+		"EDITION_JAVA":    1,
+		"EDITION_BEDROCK": 2,
+	}
+)
+
+func (x Edition) Enum() *Edition {
+	p := new(Edition)
+	*p = x
+	return p
+}
+
+func (x Edition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Edition) Descriptor() protoreflect.EnumDescriptor {
+	return file_mcstatus_v1_mcstatus_proto_enumTypes[0].Descriptor()
+}
+
+func (Edition) Type() protoreflect.EnumType {
+	return &file_mcstatus_v1_mcstatus_proto_enumTypes[0]
+}
+
+func (x Edition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Edition.Descriptor instead.
+func (Edition) EnumDescriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{0}
+}
+
+type GetStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 伺服器地址，可帶端口
+	Address string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Edition Edition `protobuf:"varint,2,opt,name=edition,proto3,enum=mcstatus.v1.Edition" json:"edition,omitempty"`
+	// 握手時宣告的客戶端協議版本，為 0 時不指定版本（僅 Java 版）
+	ProtocolVersion int32 `protobuf:"varint,3,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+}
+
+func (x *GetStatusRequest) Reset() {
+	*x = GetStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusRequest) ProtoMessage() {}
+
+func (x *GetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetStatusRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetStatusRequest) GetEdition() Edition {
+	if x != nil {
+		return x.Edition
+	}
+	return Edition_EDITION_AUTO
+}
+
+func (x *GetStatusRequest) GetProtocolVersion() int32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+type GetStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *ServerStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetStatusResponse) Reset() {
+	*x = GetStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusResponse) ProtoMessage() {}
+
+func (x *GetStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetStatusResponse) GetStatus() *ServerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type BatchGetStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*GetStatusRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *BatchGetStatusRequest) Reset() {
+	*x = BatchGetStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetStatusRequest) ProtoMessage() {}
+
+func (x *BatchGetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetStatusRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BatchGetStatusRequest) GetRequests() []*GetStatusRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BatchGetStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 結果的順序與請求相同
+	Results []*BatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchGetStatusResponse) Reset() {
+	*x = BatchGetStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetStatusResponse) ProtoMessage() {}
+
+func (x *BatchGetStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetStatusResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatchGetStatusResponse) GetResults() []*BatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// 查詢成功時設置 status，失敗時設置 error
+	Status *ServerStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Error  *Error        `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BatchResult) Reset() {
+	*x = BatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResult) ProtoMessage() {}
+
+func (x *BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResult.ProtoReflect.Descriptor instead.
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchResult) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *BatchResult) GetStatus() *ServerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *BatchResult) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type WatchStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Request *GetStatusRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// 查詢間隔（秒），預設為 30，最小為 5
+	IntervalSeconds uint32 `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+}
+
+func (x *WatchStatusRequest) Reset() {
+	*x = WatchStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchStatusRequest) ProtoMessage() {}
+
+func (x *WatchStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchStatusRequest.ProtoReflect.Descriptor instead.
+func (*WatchStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WatchStatusRequest) GetRequest() *GetStatusRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *WatchStatusRequest) GetIntervalSeconds() uint32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+type StatusUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// 查詢成功時設置 status，失敗時設置 error
+	Status *ServerStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Error  *Error        `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *StatusUpdate) Reset() {
+	*x = StatusUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusUpdate) ProtoMessage() {}
+
+func (x *StatusUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusUpdate.ProtoReflect.Descriptor instead.
+func (*StatusUpdate) Descriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StatusUpdate) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *StatusUpdate) GetStatus() *ServerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *StatusUpdate) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// Error 是單個查詢的錯誤，code 與 REST API 的錯誤類別相同
+type Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Error) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ServerStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Status:
+	//	*ServerStatus_Java
+	//	*ServerStatus_Bedrock
+	Status isServerStatus_Status `protobuf_oneof:"status"`
+}
+
+func (x *ServerStatus) Reset() {
+	*x = ServerStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerStatus) ProtoMessage() {}
+
+func (x *ServerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerStatus.ProtoReflect.Descriptor instead.
+func (*ServerStatus) Descriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *ServerStatus) GetStatus() isServerStatus_Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (x *ServerStatus) GetJava() *JavaStatus {
+	if x, ok := x.GetStatus().(*ServerStatus_Java); ok {
+		return x.Java
+	}
+	return nil
+}
+
+func (x *ServerStatus) GetBedrock() *BedrockStatus {
+	if x, ok := x.GetStatus().(*ServerStatus_Bedrock); ok {
+		return x.Bedrock
+	}
+	return nil
+}
+
+type isServerStatus_Status interface {
+	isServerStatus_Status()
+}
+
+type ServerStatus_Java struct {
+	Java *JavaStatus `protobuf:"bytes,1,opt,name=java,proto3,oneof"`
+}
+
+type ServerStatus_Bedrock struct {
+	Bedrock *BedrockStatus `protobuf:"bytes,2,opt,name=bedrock,proto3,oneof"`
+}
+
+func (*ServerStatus_Java) isServerStatus_Status() {}
+
+func (*ServerStatus_Bedrock) isServerStatus_Status() {}
+
+type JavaStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version *JavaStatus_Version `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Players *JavaStatus_Players `protobuf:"bytes,2,opt,name=players,proto3" json:"players,omitempty"`
+	// 去除格式後的 MOTD
+	Description    string           `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	MotdComponents []*MOTDComponent `protobuf:"bytes,4,rep,name=motd_components,json=motdComponents,proto3" json:"motd_components,omitempty"`
+	// 伺服器圖標（data URL）
+	Favicon             string     `protobuf:"bytes,5,opt,name=favicon,proto3" json:"favicon,omitempty"`
+	IconHash            string     `protobuf:"bytes,6,opt,name=icon_hash,json=iconHash,proto3" json:"icon_hash,omitempty"`
+	CompatibleVersions  []string   `protobuf:"bytes,7,rep,name=compatible_versions,json=compatibleVersions,proto3" json:"compatible_versions,omitempty"`
+	Software            *Software  `protobuf:"bytes,8,opt,name=software,proto3" json:"software,omitempty"`
+	EnforcesSecureChat  *bool      `protobuf:"varint,9,opt,name=enforces_secure_chat,json=enforcesSecureChat,proto3,oneof" json:"enforces_secure_chat,omitempty"`
+	PreventsChatReports *bool      `protobuf:"varint,10,opt,name=prevents_chat_reports,json=preventsChatReports,proto3,oneof" json:"prevents_chat_reports,omitempty"`
+	Forge               *ForgeInfo `protobuf:"bytes,11,opt,name=forge,proto3" json:"forge,omitempty"`
+}
+
+func (x *JavaStatus) Reset() {
+	*x = JavaStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JavaStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JavaStatus) ProtoMessage() {}
+
+func (x *JavaStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JavaStatus.ProtoReflect.Descriptor instead.
+func (*JavaStatus) Descriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *JavaStatus) GetVersion() *JavaStatus_Version {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *JavaStatus) GetPlayers() *JavaStatus_Players {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *JavaStatus) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *JavaStatus) GetMotdComponents() []*MOTDComponent {
+	if x != nil {
+		return x.MotdComponents
+	}
+	return nil
+}
+
+func (x *JavaStatus) GetFavicon() string {
+	if x != nil {
+		return x.Favicon
+	}
+	return ""
+}
+
+func (x *JavaStatus) GetIconHash() string {
+	if x != nil {
+		return x.IconHash
+	}
+	return ""
+}
+
+func (x *JavaStatus) GetCompatibleVersions() []string {
+	if x != nil {
+		return x.CompatibleVersions
+	}
+	return nil
+}
+
+func (x *JavaStatus) GetSoftware() *Software {
+	if x != nil {
+		return x.Software
+	}
+	return nil
+}
+
+func (x *JavaStatus) GetEnforcesSecureChat() bool {
+	if x != nil && x.EnforcesSecureChat != nil {
+		return *x.EnforcesSecureChat
+	}
+	return false
+}
+
+func (x *JavaStatus) GetPreventsChatReports() bool {
+	if x != nil && x.PreventsChatReports != nil {
+		return *x.PreventsChatReports
+	}
+	return false
+}
+
+func (x *JavaStatus) GetForge() *ForgeInfo {
+	if x != nil {
+		return x.Forge
+	}
+	return nil
+}
+
+type BedrockStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameType       string           `protobuf:"bytes,1,opt,name=game_type,json=gameType,proto3" json:"game_type,omitempty"`
+	Motd           string           `protobuf:"bytes,2,opt,name=motd,proto3" json:"motd,omitempty"`
+	MotdComponents []*MOTDComponent `protobuf:"bytes,3,rep,name=motd_components,json=motdComponents,proto3" json:"motd_components,omitempty"`
+	Protocol       int32            `protobuf:"varint,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Version        string           `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	PlayersOnline  int32            `protobuf:"varint,6,opt,name=players_online,json=playersOnline,proto3" json:"players_online,omitempty"`
+	PlayersMax     int32            `protobuf:"varint,7,opt,name=players_max,json=playersMax,proto3" json:"players_max,omitempty"`
+	ServerId       string           `protobuf:"bytes,8,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	MapName        string           `protobuf:"bytes,9,opt,name=map_name,json=mapName,proto3" json:"map_name,omitempty"`
+	Gamemode       string           `protobuf:"bytes,10,opt,name=gamemode,proto3" json:"gamemode,omitempty"`
+	PortIpv4       int32            `protobuf:"varint,11,opt,name=port_ipv4,json=portIpv4,proto3" json:"port_ipv4,omitempty"`
+	PortIpv6       int32            `protobuf:"varint,12,opt,name=port_ipv6,json=portIpv6,proto3" json:"port_ipv6,omitempty"`
+}
+
+func (x *BedrockStatus) Reset() {
+	*x = BedrockStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BedrockStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BedrockStatus) ProtoMessage() {}
+
+func (x *BedrockStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BedrockStatus.ProtoReflect.Descriptor instead.
+func (*BedrockStatus) Descriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BedrockStatus) GetGameType() string {
+	if x != nil {
+		return x.GameType
+	}
+	return ""
+}
+
+func (x *BedrockStatus) GetMotd() string {
+	if x != nil {
+		return x.Motd
+	}
+	return ""
+}
+
+func (x *BedrockStatus) GetMotdComponents() []*MOTDComponent {
+	if x != nil {
+		return x.MotdComponents
+	}
+	return nil
+}
+
+func (x *BedrockStatus) GetProtocol() int32 {
+	if x != nil {
+		return x.Protocol
+	}
+	return 0
+}
+
+func (x *BedrockStatus) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *BedrockStatus) GetPlayersOnline() int32 {
+	if x != nil {
+		return x.PlayersOnline
+	}
+	return 0
+}
+
+func (x *BedrockStatus) GetPlayersMax() int32 {
+	if x != nil {
+		return x.PlayersMax
+	}
+	return 0
+}
+
+func (x *BedrockStatus) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *BedrockStatus) GetMapName() string {
+	if x != nil {
+		return x.MapName
+	}
+	return ""
+}
+
+func (x *BedrockStatus) GetGamemode() string {
+	if x != nil {
+		return x.Gamemode
+	}
+	return ""
+}
+
+func (x *BedrockStatus) GetPortIpv4() int32 {
+	if x != nil {
+		return x.PortIpv4
+	}
+	return 0
+}
+
+func (x *BedrockStatus) GetPortIpv6() int32 {
+	if x != nil {
+		return x.PortIpv6
+	}
+	return 0
+}
+
+// MOTDComponent 是 MOTD 中一段具有相同格式的文本
+type MOTDComponent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text          string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Color         string `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	Bold          bool   `protobuf:"varint,3,opt,name=bold,proto3" json:"bold,omitempty"`
+	Italic        bool   `protobuf:"varint,4,opt,name=italic,proto3" json:"italic,omitempty"`
+	Underlined    bool   `protobuf:"varint,5,opt,name=underlined,proto3" json:"underlined,omitempty"`
+	Strikethrough bool   `protobuf:"varint,6,opt,name=strikethrough,proto3" json:"strikethrough,omitempty"`
+	Obfuscated    bool   `protobuf:"varint,7,opt,name=obfuscated,proto3" json:"obfuscated,omitempty"`
+}
+
+func (x *MOTDComponent) Reset() {
+	*x = MOTDComponent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MOTDComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MOTDComponent) ProtoMessage() {}
+
+func (x *MOTDComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MOTDComponent.ProtoReflect.Descriptor instead.
+func (*MOTDComponent) Descriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MOTDComponent) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *MOTDComponent) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *MOTDComponent) GetBold() bool {
+	if x != nil {
+		return x.Bold
+	}
+	return false
+}
+
+func (x *MOTDComponent) GetItalic() bool {
+	if x != nil {
+		return x.Italic
+	}
+	return false
+}
+
+func (x *MOTDComponent) GetUnderlined() bool {
+	if x != nil {
+		return x.Underlined
+	}
+	return false
+}
+
+func (x *MOTDComponent) GetStrikethrough() bool {
+	if x != nil {
+		return x.Strikethrough
+	}
+	return false
+}
+
+func (x *MOTDComponent) GetObfuscated() bool {
+	if x != nil {
+		return x.Obfuscated
+	}
+	return false
+}
+
+type Software struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Proxy bool   `protobuf:"varint,2,opt,name=proxy,proto3" json:"proxy,omitempty"`
+}
+
+func (x *Software) Reset() {
+	*x = Software{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Software) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Software) ProtoMessage() {}
+
+func (x *Software) ProtoReflect() protoreflect.Message {
+	mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Software.ProtoReflect.Descriptor instead.
+func (*Software) Descriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Software) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Software) GetProxy() bool {
+	if x != nil {
+		return x.Proxy
+	}
+	return false
+}
+
+type ForgeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type              string      `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	FmlNetworkVersion int32       `protobuf:"varint,2,opt,name=fml_network_version,json=fmlNetworkVersion,proto3" json:"fml_network_version,omitempty"`
+	Mods              []*ForgeMod `protobuf:"bytes,3,rep,name=mods,proto3" json:"mods,omitempty"`
+	Truncated         bool        `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *ForgeInfo) Reset() {
+	*x = ForgeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForgeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgeInfo) ProtoMessage() {}
+
+func (x *ForgeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgeInfo.ProtoReflect.Descriptor instead.
+func (*ForgeInfo) Descriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ForgeInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ForgeInfo) GetFmlNetworkVersion() int32 {
+	if x != nil {
+		return x.FmlNetworkVersion
+	}
+	return 0
+}
+
+func (x *ForgeInfo) GetMods() []*ForgeMod {
+	if x != nil {
+		return x.Mods
+	}
+	return nil
+}
+
+func (x *ForgeInfo) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type ForgeMod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version    string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	ServerOnly bool   `protobuf:"varint,3,opt,name=server_only,json=serverOnly,proto3" json:"server_only,omitempty"`
+}
+
+func (x *ForgeMod) Reset() {
+	*x = ForgeMod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForgeMod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgeMod) ProtoMessage() {}
+
+func (x *ForgeMod) ProtoReflect() protoreflect.Message {
+	mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgeMod.ProtoReflect.Descriptor instead.
+func (*ForgeMod) Descriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ForgeMod) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ForgeMod) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ForgeMod) GetServerOnly() bool {
+	if x != nil {
+		return x.ServerOnly
+	}
+	return false
+}
+
+type JavaStatus_Version struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Protocol int32  `protobuf:"varint,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+}
+
+func (x *JavaStatus_Version) Reset() {
+	*x = JavaStatus_Version{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JavaStatus_Version) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JavaStatus_Version) ProtoMessage() {}
+
+func (x *JavaStatus_Version) ProtoReflect() protoreflect.Message {
+	mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JavaStatus_Version.ProtoReflect.Descriptor instead.
+func (*JavaStatus_Version) Descriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *JavaStatus_Version) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JavaStatus_Version) GetProtocol() int32 {
+	if x != nil {
+		return x.Protocol
+	}
+	return 0
+}
+
+type JavaStatus_Players struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Online int32                `protobuf:"varint,1,opt,name=online,proto3" json:"online,omitempty"`
+	Max    int32                `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	Sample []*JavaStatus_Player `protobuf:"bytes,3,rep,name=sample,proto3" json:"sample,omitempty"`
+}
+
+func (x *JavaStatus_Players) Reset() {
+	*x = JavaStatus_Players{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JavaStatus_Players) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JavaStatus_Players) ProtoMessage() {}
+
+func (x *JavaStatus_Players) ProtoReflect() protoreflect.Message {
+	mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JavaStatus_Players.ProtoReflect.Descriptor instead.
+func (*JavaStatus_Players) Descriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *JavaStatus_Players) GetOnline() int32 {
+	if x != nil {
+		return x.Online
+	}
+	return 0
+}
+
+func (x *JavaStatus_Players) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *JavaStatus_Players) GetSample() []*JavaStatus_Player {
+	if x != nil {
+		return x.Sample
+	}
+	return nil
+}
+
+type JavaStatus_Player struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id   string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *JavaStatus_Player) Reset() {
+	*x = JavaStatus_Player{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JavaStatus_Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JavaStatus_Player) ProtoMessage() {}
+
+func (x *JavaStatus_Player) ProtoReflect() protoreflect.Message {
+	mi := &file_mcstatus_v1_mcstatus_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JavaStatus_Player.ProtoReflect.Descriptor instead.
+func (*JavaStatus_Player) Descriptor() ([]byte, []int) {
+	return file_mcstatus_v1_mcstatus_proto_rawDescGZIP(), []int{9, 2}
+}
+
+func (x *JavaStatus_Player) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JavaStatus_Player) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_mcstatus_v1_mcstatus_proto protoreflect.FileDescriptor
+
+var file_mcstatus_v1_mcstatus_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6d, 0x63, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x63,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6d, 0x63,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x87, 0x01, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x63, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x63, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x52, 0x0a, 0x15,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x63, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x22, 0x4c, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x63,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x84,
+	0x01, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x63, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x63, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x78, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d,
+	0x63, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22,
+	0x9b, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x6d, 0x63, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x63, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x35, 0x0a,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x7f, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x6a, 0x61, 0x76, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x63, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4a, 0x61, 0x76, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x04, 0x6a,
+	0x61, 0x76, 0x61, 0x12, 0x36, 0x0a, 0x07, 0x62, 0x65, 0x64, 0x72, 0x6f, 0x63, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x63, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x65, 0x64, 0x72, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x48, 0x00, 0x52, 0x07, 0x62, 0x65, 0x64, 0x72, 0x6f, 0x63, 0x6b, 0x42, 0x08, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xab, 0x06, 0x0a, 0x0a, 0x4a, 0x61, 0x76, 0x61, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x63, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x61, 0x76, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x39, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6d, 0x63, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4a,
+	0x61, 0x76, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0f,
+	0x6d, 0x6f, 0x74, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x63, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x4f, 0x54, 0x44, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x52, 0x0e, 0x6d, 0x6f, 0x74, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x76, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x76, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69,
+	0x63, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x69, 0x63, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x6f, 0x66,
+	0x74, 0x77, 0x61, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x63,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61,
+	0x72, 0x65, 0x52, 0x08, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x12, 0x35, 0x0a, 0x14,
+	0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f,
+	0x63, 0x68, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x12, 0x65, 0x6e,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x73, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x43, 0x68, 0x61, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x15, 0x70, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f,
+	0x63, 0x68, 0x61, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x01, 0x52, 0x13, 0x70, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x05,
+	0x66, 0x6f, 0x72, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x63,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x1a, 0x39, 0x0a, 0x07, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x1a, 0x6b, 0x0a, 0x07, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x63, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x61, 0x76, 0x61, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x1a, 0x2c, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x42, 0x17, 0x0a, 0x15, 0x5f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x70, 0x72,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x22, 0x91, 0x03, 0x0a, 0x0d, 0x42, 0x65, 0x64, 0x72, 0x6f, 0x63, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x74, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6d, 0x6f, 0x74, 0x64, 0x12, 0x43, 0x0a, 0x0f, 0x6d, 0x6f, 0x74, 0x64, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x6d, 0x63, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x4f,
+	0x54, 0x44, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x6d, 0x6f, 0x74,
+	0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x4d, 0x61, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x70, 0x76, 0x34, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x6f, 0x72, 0x74, 0x49, 0x70, 0x76, 0x36, 0x22, 0xcb, 0x01, 0x0a, 0x0d, 0x4d, 0x4f, 0x54, 0x44,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x62, 0x6f, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x74, 0x61, 0x6c, 0x69,
+	0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x74, 0x61, 0x6c, 0x69, 0x63, 0x12,
+	0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x12,
+	0x24, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x74, 0x68,
+	0x72, 0x6f, 0x75, 0x67, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x62, 0x66, 0x75, 0x73,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x34, 0x0a, 0x08, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x22, 0x98, 0x01, 0x0a, 0x09,
+	0x46, 0x6f, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a,
+	0x13, 0x66, 0x6d, 0x6c, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x66, 0x6d, 0x6c, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x63,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x4d,
+	0x6f, 0x64, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75,
+	0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x55, 0x0a, 0x08, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x4d,
+	0x6f, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x79, 0x2a, 0x42, 0x0a,
+	0x07, 0x45, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x44, 0x49, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x44,
+	0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4a, 0x41, 0x56, 0x41, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
+	0x45, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x45, 0x44, 0x52, 0x4f, 0x43, 0x4b, 0x10,
+	0x02, 0x32, 0x89, 0x02, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x63, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x63, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x2e, 0x6d, 0x63, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x63,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4b, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1f, 0x2e, 0x6d, 0x63, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x6d, 0x63, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x42, 0x21, 0x5a,
+	0x1f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x63, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mcstatus_v1_mcstatus_proto_rawDescOnce sync.Once
+	file_mcstatus_v1_mcstatus_proto_rawDescData = file_mcstatus_v1_mcstatus_proto_rawDesc
+)
+
+func file_mcstatus_v1_mcstatus_proto_rawDescGZIP() []byte {
+	file_mcstatus_v1_mcstatus_proto_rawDescOnce.Do(func() {
+		file_mcstatus_v1_mcstatus_proto_rawDescData = protoimpl.X.CompressGZIP(file_mcstatus_v1_mcstatus_proto_rawDescData)
+	})
+	return file_mcstatus_v1_mcstatus_proto_rawDescData
+}
+
+var file_mcstatus_v1_mcstatus_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_mcstatus_v1_mcstatus_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_mcstatus_v1_mcstatus_proto_goTypes = []any{
+	(Edition)(0),                   // 0: mcstatus.v1.Edition
+	(*GetStatusRequest)(nil),       // 1: mcstatus.v1.GetStatusRequest
+	(*GetStatusResponse)(nil),      // 2: mcstatus.v1.GetStatusResponse
+	(*BatchGetStatusRequest)(nil),  // 3: mcstatus.v1.BatchGetStatusRequest
+	(*BatchGetStatusResponse)(nil), // 4: mcstatus.v1.BatchGetStatusResponse
+	(*BatchResult)(nil),            // 5: mcstatus.v1.BatchResult
+	(*WatchStatusRequest)(nil),     // 6: mcstatus.v1.WatchStatusRequest
+	(*StatusUpdate)(nil),           // 7: mcstatus.v1.StatusUpdate
+	(*Error)(nil),                  // 8: mcstatus.v1.Error
+	(*ServerStatus)(nil),           // 9: mcstatus.v1.ServerStatus
+	(*JavaStatus)(nil),             // 10: mcstatus.v1.JavaStatus
+	(*BedrockStatus)(nil),          // 11: mcstatus.v1.BedrockStatus
+	(*MOTDComponent)(nil),          // 12: mcstatus.v1.MOTDComponent
+	(*Software)(nil),               // 13: mcstatus.v1.Software
+	(*ForgeInfo)(nil),              // 14: mcstatus.v1.ForgeInfo
+	(*ForgeMod)(nil),               // 15: mcstatus.v1.ForgeMod
+	(*JavaStatus_Version)(nil),     // 16: mcstatus.v1.JavaStatus.Version
+	(*JavaStatus_Players)(nil),     // 17: mcstatus.v1.JavaStatus.Players
+	(*JavaStatus_Player)(nil),      // 18: mcstatus.v1.JavaStatus.Player
+	(*timestamppb.Timestamp)(nil),  // 19: google.protobuf.Timestamp
+}
+var file_mcstatus_v1_mcstatus_proto_depIdxs = []int32{
+	0,  // 0: mcstatus.v1.GetStatusRequest.edition:type_name -> mcstatus.v1.Edition
+	9,  // 1: mcstatus.v1.GetStatusResponse.status:type_name -> mcstatus.v1.ServerStatus
+	1,  // 2: mcstatus.v1.BatchGetStatusRequest.requests:type_name -> mcstatus.v1.GetStatusRequest
+	5,  // 3: mcstatus.v1.BatchGetStatusResponse.results:type_name -> mcstatus.v1.BatchResult
+	9,  // 4: mcstatus.v1.BatchResult.status:type_name -> mcstatus.v1.ServerStatus
+	8,  // 5: mcstatus.v1.BatchResult.error:type_name -> mcstatus.v1.Error
+	1,  // 6: mcstatus.v1.WatchStatusRequest.request:type_name -> mcstatus.v1.GetStatusRequest
+	19, // 7: mcstatus.v1.StatusUpdate.time:type_name -> google.protobuf.Timestamp
+	9,  // 8: mcstatus.v1.StatusUpdate.status:type_name -> mcstatus.v1.ServerStatus
+	8,  // 9: mcstatus.v1.StatusUpdate.error:type_name -> mcstatus.v1.Error
+	10, // 10: mcstatus.v1.ServerStatus.java:type_name -> mcstatus.v1.JavaStatus
+	11, // 11: mcstatus.v1.ServerStatus.bedrock:type_name -> mcstatus.v1.BedrockStatus
+	16, // 12: mcstatus.v1.JavaStatus.version:type_name -> mcstatus.v1.JavaStatus.Version
+	17, // 13: mcstatus.v1.JavaStatus.players:type_name -> mcstatus.v1.JavaStatus.Players
+	12, // 14: mcstatus.v1.JavaStatus.motd_components:type_name -> mcstatus.v1.MOTDComponent
+	13, // 15: mcstatus.v1.JavaStatus.software:type_name -> mcstatus.v1.Software
+	14, // 16: mcstatus.v1.JavaStatus.forge:type_name -> mcstatus.v1.ForgeInfo
+	12, // 17: mcstatus.v1.BedrockStatus.motd_components:type_name -> mcstatus.v1.MOTDComponent
+	15, // 18: mcstatus.v1.ForgeInfo.mods:type_name -> mcstatus.v1.ForgeMod
+	18, // 19: mcstatus.v1.JavaStatus.Players.sample:type_name -> mcstatus.v1.JavaStatus.Player
+	1,  // 20: mcstatus.v1.ServerStatusService.GetStatus:input_type -> mcstatus.v1.GetStatusRequest
+	3,  // 21: mcstatus.v1.ServerStatusService.BatchGetStatus:input_type -> mcstatus.v1.BatchGetStatusRequest
+	6,  // 22: mcstatus.v1.ServerStatusService.WatchStatus:input_type -> mcstatus.v1.WatchStatusRequest
+	2,  // 23: mcstatus.v1.ServerStatusService.GetStatus:output_type -> mcstatus.v1.GetStatusResponse
+	4,  // 24: mcstatus.v1.ServerStatusService.BatchGetStatus:output_type -> mcstatus.v1.BatchGetStatusResponse
+	7,  // 25: mcstatus.v1.ServerStatusService.WatchStatus:output_type -> mcstatus.v1.StatusUpdate
+	23, // [23:26] is the sub-list for method output_type
+	20, // [20:23] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_mcstatus_v1_mcstatus_proto_init() }
+func file_mcstatus_v1_mcstatus_proto_init() {
+	if File_mcstatus_v1_mcstatus_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mcstatus_v1_mcstatus_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*GetStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mcstatus_v1_mcstatus_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GetStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mcstatus_v1_mcstatus_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchGetStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mcstatus_v1_mcstatus_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchGetStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mcstatus_v1_mcstatus_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mcstatus_v1_mcstatus_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mcstatus_v1_mcstatus_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*StatusUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mcstatus_v1_mcstatus_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mcstatus_v1_mcstatus_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ServerStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mcstatus_v1_mcstatus_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*JavaStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mcstatus_v1_mcstatus_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*BedrockStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mcstatus_v1_mcstatus_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*MOTDComponent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mcstatus_v1_mcstatus_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*Software); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mcstatus_v1_mcstatus_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*ForgeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mcstatus_v1_mcstatus_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ForgeMod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mcstatus_v1_mcstatus_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*JavaStatus_Version); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mcstatus_v1_mcstatus_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*JavaStatus_Players); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mcstatus_v1_mcstatus_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*JavaStatus_Player); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_mcstatus_v1_mcstatus_proto_msgTypes[8].OneofWrappers = []any{
+		(*ServerStatus_Java)(nil),
+		(*ServerStatus_Bedrock)(nil),
+	}
+	file_mcstatus_v1_mcstatus_proto_msgTypes[9].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mcstatus_v1_mcstatus_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_mcstatus_v1_mcstatus_proto_goTypes,
+		DependencyIndexes: file_mcstatus_v1_mcstatus_proto_depIdxs,
+		EnumInfos:         file_mcstatus_v1_mcstatus_proto_enumTypes,
+		MessageInfos:      file_mcstatus_v1_mcstatus_proto_msgTypes,
+	}.Build()
+	File_mcstatus_v1_mcstatus_proto = out.File
+	file_mcstatus_v1_mcstatus_proto_rawDesc = nil
+	file_mcstatus_v1_mcstatus_proto_goTypes = nil
+	file_mcstatus_v1_mcstatus_proto_depIdxs = nil
+}
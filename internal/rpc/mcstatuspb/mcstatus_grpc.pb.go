@@ -0,0 +1,224 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             v5.27.1
+// source: mcstatus/v1/mcstatus.proto
+
+package mcstatuspb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	ServerStatusService_GetStatus_FullMethodName      = "/mcstatus.v1.ServerStatusService/GetStatus"
+	ServerStatusService_BatchGetStatus_FullMethodName = "/mcstatus.v1.ServerStatusService/BatchGetStatus"
+	ServerStatusService_WatchStatus_FullMethodName    = "/mcstatus.v1.ServerStatusService/WatchStatus"
+)
+
+// ServerStatusServiceClient is the client API for ServerStatusService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ServerStatusService 提供 Minecraft 伺服器狀態查詢，與 REST API 共用同一個查詢實現
+type ServerStatusServiceClient interface {
+	// GetStatus 查詢單個伺服器的狀態
+	GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error)
+	// BatchGetStatus 並發查詢多個伺服器的狀態，單個伺服器查詢失敗不影響其他結果
+	BatchGetStatus(ctx context.Context, in *BatchGetStatusRequest, opts ...grpc.CallOption) (*BatchGetStatusResponse, error)
+	// WatchStatus 定期查詢伺服器狀態，狀態變化時推送更新
+	WatchStatus(ctx context.Context, in *WatchStatusRequest, opts ...grpc.CallOption) (ServerStatusService_WatchStatusClient, error)
+}
+
+type serverStatusServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServerStatusServiceClient(cc grpc.ClientConnInterface) ServerStatusServiceClient {
+	return &serverStatusServiceClient{cc}
+}
+
+func (c *serverStatusServiceClient) GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatusResponse)
+	err := c.cc.Invoke(ctx, ServerStatusService_GetStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverStatusServiceClient) BatchGetStatus(ctx context.Context, in *BatchGetStatusRequest, opts ...grpc.CallOption) (*BatchGetStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetStatusResponse)
+	err := c.cc.Invoke(ctx, ServerStatusService_BatchGetStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverStatusServiceClient) WatchStatus(ctx context.Context, in *WatchStatusRequest, opts ...grpc.CallOption) (ServerStatusService_WatchStatusClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ServerStatusService_ServiceDesc.Streams[0], ServerStatusService_WatchStatus_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serverStatusServiceWatchStatusClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ServerStatusService_WatchStatusClient interface {
+	Recv() (*StatusUpdate, error)
+	grpc.ClientStream
+}
+
+type serverStatusServiceWatchStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *serverStatusServiceWatchStatusClient) Recv() (*StatusUpdate, error) {
+	m := new(StatusUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ServerStatusServiceServer is the server API for ServerStatusService service.
+// All implementations must embed UnimplementedServerStatusServiceServer
+// for forward compatibility
+//
+// ServerStatusService 提供 Minecraft 伺服器狀態查詢，與 REST API 共用同一個查詢實現
+type ServerStatusServiceServer interface {
+	// GetStatus 查詢單個伺服器的狀態
+	GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error)
+	// BatchGetStatus 並發查詢多個伺服器的狀態，單個伺服器查詢失敗不影響其他結果
+	BatchGetStatus(context.Context, *BatchGetStatusRequest) (*BatchGetStatusResponse, error)
+	// WatchStatus 定期查詢伺服器狀態，狀態變化時推送更新
+	WatchStatus(*WatchStatusRequest, ServerStatusService_WatchStatusServer) error
+	mustEmbedUnimplementedServerStatusServiceServer()
+}
+
+// UnimplementedServerStatusServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedServerStatusServiceServer struct {
+}
+
+func (UnimplementedServerStatusServiceServer) GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (UnimplementedServerStatusServiceServer) BatchGetStatus(context.Context, *BatchGetStatusRequest) (*BatchGetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetStatus not implemented")
+}
+func (UnimplementedServerStatusServiceServer) WatchStatus(*WatchStatusRequest, ServerStatusService_WatchStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStatus not implemented")
+}
+func (UnimplementedServerStatusServiceServer) mustEmbedUnimplementedServerStatusServiceServer() {}
+
+// UnsafeServerStatusServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServerStatusServiceServer will
+// result in compilation errors.
+type UnsafeServerStatusServiceServer interface {
+	mustEmbedUnimplementedServerStatusServiceServer()
+}
+
+func RegisterServerStatusServiceServer(s grpc.ServiceRegistrar, srv ServerStatusServiceServer) {
+	s.RegisterService(&ServerStatusService_ServiceDesc, srv)
+}
+
+func _ServerStatusService_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerStatusServiceServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerStatusService_GetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerStatusServiceServer).GetStatus(ctx, req.(*GetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerStatusService_BatchGetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerStatusServiceServer).BatchGetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerStatusService_BatchGetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerStatusServiceServer).BatchGetStatus(ctx, req.(*BatchGetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerStatusService_WatchStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServerStatusServiceServer).WatchStatus(m, &serverStatusServiceWatchStatusServer{ServerStream: stream})
+}
+
+type ServerStatusService_WatchStatusServer interface {
+	Send(*StatusUpdate) error
+	grpc.ServerStream
+}
+
+type serverStatusServiceWatchStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *serverStatusServiceWatchStatusServer) Send(m *StatusUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// ServerStatusService_ServiceDesc is the grpc.ServiceDesc for ServerStatusService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServerStatusService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mcstatus.v1.ServerStatusService",
+	HandlerType: (*ServerStatusServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStatus",
+			Handler:    _ServerStatusService_GetStatus_Handler,
+		},
+		{
+			MethodName: "BatchGetStatus",
+			Handler:    _ServerStatusService_BatchGetStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStatus",
+			Handler:       _ServerStatusService_WatchStatus_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "mcstatus/v1/mcstatus.proto",
+}
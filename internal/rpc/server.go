@@ -0,0 +1,200 @@
+// Package rpc 提供與 REST API 共用查詢實現的 gRPC 服務
+package rpc
+
+import (
+	pb "backend/internal/rpc/mcstatuspb"
+	"backend/mcstatus"
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// 批量查詢和監視的限制
+const (
+	maxBatchSize         = 50               // 單次批量查詢的最大伺服器數
+	batchConcurrency     = 8                // 批量查詢的最大並發數
+	defaultWatchInterval = 30 * time.Second // 監視的默認查詢間隔
+	minWatchInterval     = 5 * time.Second  // 監視的最小查詢間隔
+)
+
+// Server 實現 ServerStatusService
+type Server struct {
+	pb.UnimplementedServerStatusServiceServer
+}
+
+// ListenAndServe 在指定地址上啟動 gRPC 服務
+func ListenAndServe(addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	s := grpc.NewServer()
+	pb.RegisterServerStatusServiceServer(s, &Server{})
+	return s.Serve(lis)
+}
+
+// GetStatus 查詢單個伺服器的狀態
+func (s *Server) GetStatus(ctx context.Context, req *pb.GetStatusRequest) (*pb.GetStatusResponse, error) {
+	if req.GetAddress() == "" {
+		return nil, status.Error(codes.InvalidArgument, "伺服器地址不能為空")
+	}
+	result, err := query(ctx, req)
+	if err != nil {
+		return nil, status.Error(grpcCode(mcstatus.ErrorCodeOf(err)), err.Error())
+	}
+	return &pb.GetStatusResponse{Status: result}, nil
+}
+
+// BatchGetStatus 並發查詢多個伺服器的狀態
+func (s *Server) BatchGetStatus(ctx context.Context, req *pb.BatchGetStatusRequest) (*pb.BatchGetStatusResponse, error) {
+	if len(req.GetRequests()) > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "單次最多查詢 %d 個伺服器", maxBatchSize)
+	}
+
+	results := make([]*pb.BatchResult, len(req.GetRequests()))
+	sem := make(chan struct{}, batchConcurrency)
+	var wg sync.WaitGroup
+	for i, r := range req.GetRequests() {
+		wg.Add(1)
+		go func(i int, r *pb.GetStatusRequest) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			result := &pb.BatchResult{Address: r.GetAddress()}
+			result.Status, result.Error = queryResult(ctx, r)
+			results[i] = result
+		}(i, r)
+	}
+	wg.Wait()
+
+	return &pb.BatchGetStatusResponse{Results: results}, nil
+}
+
+// WatchStatus 定期查詢伺服器狀態，第一次查詢的結果總是推送，之後只在狀態變化時推送
+func (s *Server) WatchStatus(req *pb.WatchStatusRequest, stream pb.ServerStatusService_WatchStatusServer) error {
+	if req.GetRequest().GetAddress() == "" {
+		return status.Error(codes.InvalidArgument, "伺服器地址不能為空")
+	}
+	interval := defaultWatchInterval
+	if req.GetIntervalSeconds() > 0 {
+		interval = max(time.Duration(req.GetIntervalSeconds())*time.Second, minWatchInterval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last *pb.StatusUpdate
+	for {
+		update := &pb.StatusUpdate{}
+		update.Status, update.Error = queryResult(stream.Context(), req.GetRequest())
+		if last == nil || !proto.Equal(update.Status, last.Status) || !proto.Equal(update.Error, last.Error) {
+			update.Time = timestamppb.Now()
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+			last = update
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// query 根據請求的版本查詢伺服器狀態，ctx 被取消時中止查詢
+// 與 REST API 一樣，不帶額外選項的查詢使用狀態緩存
+func query(ctx context.Context, req *pb.GetStatusRequest) (*pb.ServerStatus, error) {
+	client := peerIP(ctx)
+	edition := "auto"
+	switch req.GetEdition() {
+	case pb.Edition_EDITION_JAVA:
+		edition = mcstatus.EditionJava
+	case pb.Edition_EDITION_BEDROCK:
+		edition = mcstatus.EditionBedrock
+	}
+
+	if req.GetProtocolVersion() == 0 {
+		result, _, err := mcstatus.GetCachedStatusContext(ctx, edition, req.GetAddress(), mcstatus.CacheOptions{Client: client})
+		if err != nil {
+			return nil, err
+		}
+		return StatusProto(result), nil
+	}
+
+	opts := mcstatus.QueryOptions{ProtocolVersion: req.GetProtocolVersion(), Client: client}
+	result := &mcstatus.EditionStatus{Edition: edition}
+	var err error
+	switch edition {
+	case mcstatus.EditionJava:
+		result.Java, err = mcstatus.GetServerStatusContext(ctx, req.GetAddress(), opts)
+	case mcstatus.EditionBedrock:
+		result.Bedrock, err = mcstatus.GetBedrockStatusContext(ctx, req.GetAddress(), opts)
+	default:
+		result, err = mcstatus.GetStatusAutoEditionContext(ctx, req.GetAddress(), opts)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return StatusProto(result), nil
+}
+
+// peerIP 返回發起 RPC 的客戶端 IP，用於限流和統計
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+		return host
+	}
+	return p.Addr.String()
+}
+
+// queryResult 查詢伺服器狀態，失敗時將錯誤轉換為 Error 消息
+func queryResult(ctx context.Context, req *pb.GetStatusRequest) (*pb.ServerStatus, *pb.Error) {
+	if req.GetAddress() == "" {
+		return nil, &pb.Error{Code: "INVALID_REQUEST", Message: "伺服器地址不能為空"}
+	}
+	result, err := query(ctx, req)
+	if err != nil {
+		code := mcstatus.ErrorCodeOf(err)
+		if code == "" {
+			code = "INTERNAL_ERROR"
+		}
+		return nil, &pb.Error{Code: string(code), Message: err.Error()}
+	}
+	return result, nil
+}
+
+// grpcCode 將查詢錯誤的類別轉換為 gRPC 狀態碼
+func grpcCode(code mcstatus.ErrorCode) codes.Code {
+	switch code {
+	case mcstatus.CodeInvalidAddress, mcstatus.CodeInvalidBindAddress:
+		return codes.InvalidArgument
+	case mcstatus.CodeDNSFailure, mcstatus.CodeNoFavicon:
+		return codes.NotFound
+	case mcstatus.CodeConnectTimeout, mcstatus.CodeReadTimeout:
+		return codes.DeadlineExceeded
+	case mcstatus.CodeConnectionRefused, mcstatus.CodeConnectionFailed:
+		return codes.Unavailable
+	case mcstatus.CodeInvalidPacket, mcstatus.CodeParseError, mcstatus.CodeInvalidFavicon:
+		return codes.DataLoss
+	case mcstatus.CodeHostBusy:
+		return codes.ResourceExhausted
+	case mcstatus.CodeBedrockDisabled:
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
@@ -0,0 +1,124 @@
+// Package server 負責啟動 HTTP 服務，可選通過 Let's Encrypt 自動獲取證書並提供 HTTPS
+package server
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// Options 是 HTTP 服務的設定
+type Options struct {
+	Port           string      // HTTP 服務監聽的端口，啟用 TLS 時只用於 ACME 驗證和重定向到 HTTPS
+	TLSDomains     []string    // 自動申請證書的域名（為空時不啟用 TLS）
+	TLSPort        string      // HTTPS 服務監聽的端口
+	TLSCacheDir    string      // 保存證書的目錄
+	TLSEmail       string      // 向 Let's Encrypt 註冊時使用的聯繫郵箱（可選）
+	UnixSocket     string      // 代替 TCP 端口監聽的 Unix 套接字路徑（為空時監聽 TCP 端口）
+	UnixSocketMode os.FileMode // Unix 套接字文件的權限
+}
+
+// ListenAndServe 啟動 HTTP 服務，直到發生錯誤才返回
+func ListenAndServe(handler http.Handler, opts Options) error {
+	ln, err := systemdListener()
+	if err != nil {
+		return err
+	}
+	if ln != nil {
+		if len(opts.TLSDomains) > 0 {
+			ln.Close()
+			return errors.New("systemd 套接字激活不能與自動 TLS 同時使用")
+		}
+		log.Printf("Server starting on %s socket %s inherited from systemd", ln.Addr().Network(), ln.Addr())
+		return serve(ln, handler)
+	}
+	if opts.UnixSocket != "" {
+		if len(opts.TLSDomains) > 0 {
+			return errors.New("Unix 套接字不能與自動 TLS 同時使用")
+		}
+		return listenAndServeUnix(handler, opts.UnixSocket, opts.UnixSocketMode)
+	}
+	if len(opts.TLSDomains) == 0 {
+		log.Printf("Server starting on port %s", opts.Port)
+		return http.ListenAndServe(":"+opts.Port, handler)
+	}
+	return listenAndServeTLS(handler, opts)
+}
+
+// listenAndServeTLS 通過 Let's Encrypt 自動獲取和續期證書並提供 HTTPS
+// HTTPS 端口上使用 tls-alpn-01 驗證；HTTP 端口處理 http-01 驗證，其他請求重定向到 HTTPS
+func listenAndServeTLS(handler http.Handler, opts Options) error {
+	m := &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		HostPolicy: autocert.HostWhitelist(opts.TLSDomains...),
+		Cache:      autocert.DirCache(opts.TLSCacheDir),
+		Email:      opts.TLSEmail,
+	}
+
+	go func() {
+		log.Printf("HTTP server for ACME challenges starting on port %s", opts.Port)
+		if err := http.ListenAndServe(":"+opts.Port, m.HTTPHandler(redirectToHTTPS(opts.TLSPort))); err != nil {
+			log.Printf("HTTP server for ACME challenges stopped: %v", err)
+		}
+	}()
+
+	srv := &http.Server{
+		Addr:      ":" + opts.TLSPort,
+		Handler:   handler,
+		TLSConfig: m.TLSConfig(),
+	}
+	log.Printf("HTTPS server starting on port %s for %v", opts.TLSPort, opts.TLSDomains)
+	return srv.ListenAndServeTLS("", "")
+}
+
+// listenAndServeUnix 在 Unix 套接字上提供 HTTP 服務，適用於反向代理與本服務在同一主機上的部署
+// 啟動時移除上次運行遺留的套接字文件，但不會刪除同名的普通文件
+func listenAndServeUnix(handler http.Handler, path string, mode os.FileMode) error {
+	if info, err := os.Lstat(path); err == nil && info.Mode()&os.ModeSocket != 0 {
+		if err := os.Remove(path); err != nil {
+			return fmt.Errorf("移除舊的套接字文件失敗: %w", err)
+		}
+	}
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return err
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		ln.Close()
+		return fmt.Errorf("設置套接字文件權限失敗: %w", err)
+	}
+	log.Printf("Server starting on unix socket %s", path)
+	return serve(ln, handler)
+}
+
+// serve 在監聽套接字上提供 HTTP 服務
+// Unix 套接字的連接沒有 IP 地址，視為來自本機的反向代理，使客戶端 IP 可以從 X-Forwarded-For 等標頭取得
+func serve(ln net.Listener, handler http.Handler) error {
+	if ln.Addr().Network() == "unix" {
+		next := handler
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.RemoteAddr = "127.0.0.1:0"
+			next.ServeHTTP(w, r)
+		})
+	}
+	return http.Serve(ln, handler)
+}
+
+// redirectToHTTPS 將請求重定向到 HTTPS 端口上的相同地址
+func redirectToHTTPS(tlsPort string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.Host)
+		if err != nil {
+			host = r.Host
+		}
+		if tlsPort != "443" {
+			host = net.JoinHostPort(host, tlsPort)
+		}
+		http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusPermanentRedirect)
+	})
+}
@@ -0,0 +1,42 @@
+package server
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"strconv"
+)
+
+// listenFDsStart 是 systemd 傳遞的第一個文件描述符
+const listenFDsStart = 3
+
+// systemdListener 返回 systemd 套接字激活傳遞的監聽套接字，不是由 systemd 啟動時返回 nil
+// 協議見 sd_listen_fds(3)：LISTEN_PID 為接收套接字的進程，LISTEN_FDS 為從 3 開始的文件描述符數量
+// 使用套接字激活時，重啟服務期間的連接由 systemd 保持在隊列中，不會被拒絕
+func systemdListener() (net.Listener, error) {
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || n < 1 {
+		return nil, nil
+	}
+
+	// 避免子進程誤以為套接字是傳給它的
+	os.Unsetenv("LISTEN_PID")
+	os.Unsetenv("LISTEN_FDS")
+	os.Unsetenv("LISTEN_FDNAMES")
+
+	if n > 1 {
+		log.Printf("systemd passed %d sockets, only the first one is used", n)
+	}
+	f := os.NewFile(listenFDsStart, "systemd-socket")
+	defer f.Close()
+	ln, err := net.FileListener(f)
+	if err != nil {
+		return nil, fmt.Errorf("無法使用 systemd 傳遞的套接字: %w", err)
+	}
+	return ln, nil
+}
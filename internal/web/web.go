@@ -0,0 +1,27 @@
+// Package web 提供內置的單頁儀表板，適合不想另外部署前端的小型部署
+package web
+
+import (
+	_ "embed"
+	"html/template"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//go:embed index.html
+var indexHTML string
+
+var indexTemplate = template.Must(template.New("index").Parse(indexHTML))
+
+// Register 在 / 上註冊儀表板頁面，servers 是頁面預設顯示的伺服器列表
+func Register(r *gin.Engine, servers []string) {
+	if servers == nil {
+		servers = []string{}
+	}
+	r.GET("/", func(c *gin.Context) {
+		c.Header("Content-Type", "text/html; charset=utf-8")
+		c.Status(http.StatusOK)
+		indexTemplate.Execute(c.Writer, map[string]any{"Servers": servers})
+	})
+}
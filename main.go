@@ -2,37 +2,155 @@ package main
 
 import (
 	"backend/internal/api"
+	"backend/internal/api/handlers"
+	"backend/internal/config"
+	"backend/internal/enrich"
+	"backend/internal/feature"
+	"backend/internal/mcmock"
+	"backend/internal/rpc"
+	"backend/internal/server"
+	"backend/internal/web"
+	"backend/mcstatus"
+	"flag"
 	"log"
-	"os"
 
 	"github.com/gin-gonic/gin"
 )
 
 func main() {
-	// 根據環境變量設置 gin 模式
-	ginMode := os.Getenv("GIN_MODE")
-	if ginMode == "" {
-		ginMode = gin.ReleaseMode
+	mock := flag.Bool("mock", false, "start mock Minecraft servers on MOCK_JAVA_ADDRESS and MOCK_BEDROCK_ADDRESS for local development")
+	flag.Parse()
+
+	// 讀取設定
+	cfg := config.Load()
+
+	// 根據設定設置 gin 模式
+	gin.SetMode(cfg.GinMode)
+	log.Printf("Gin mode: %s", cfg.GinMode)
+
+	// 設置查詢服務
+	mcstatus.SetMaxConnsPerHost(cfg.MaxConnsPerHost)
+	mcstatus.SetDNSCacheTTL(cfg.DNSCacheTTL)
+	mcstatus.SetSlowQueryThreshold(cfg.SlowQueryThreshold)
+	mcstatus.SetStatusCache(cfg.StatusCacheTTL, cfg.StaleWhileRevalidate, cfg.StaleIfError)
+	if len(cfg.PrewarmServers) > 0 {
+		if err := mcstatus.PrewarmStatusCache(cfg.PrewarmServers); err != nil {
+			log.Fatalf("Failed to configure cache prewarming: %v", err)
+		}
+		log.Printf("Prewarming status cache for %d servers", len(cfg.PrewarmServers))
+	}
+	switch {
+	case cfg.DoHEndpoint != "":
+		mcstatus.SetDoHEndpoint(cfg.DoHEndpoint)
+		log.Printf("Using DNS-over-HTTPS resolver: %s", cfg.DoHEndpoint)
+	case len(cfg.DNSServers) > 0:
+		mcstatus.SetNameservers(cfg.DNSServers)
+		log.Printf("Using nameservers: %v", cfg.DNSServers)
+	}
+	if cfg.BindAddress != "" {
+		if err := mcstatus.SetBindAddress(cfg.BindAddress); err != nil {
+			log.Fatalf("Failed to configure bind address: %v", err)
+		}
+		log.Printf("Binding outbound queries to %s", cfg.BindAddress)
+	}
+	if cfg.SOCKS5Proxy != "" {
+		if err := mcstatus.SetSOCKS5Proxy(cfg.SOCKS5Proxy); err != nil {
+			log.Fatalf("Failed to configure SOCKS5 proxy: %v", err)
+		}
+		log.Println("Routing outbound queries through SOCKS5 proxy")
+	}
+	if cfg.GeoIPDatabase != "" {
+		if err := mcstatus.SetGeoIPDatabase(cfg.GeoIPDatabase); err != nil {
+			log.Fatalf("Failed to open GeoIP database: %v", err)
+		}
+		log.Printf("Using GeoIP database: %s", cfg.GeoIPDatabase)
+	}
+	if cfg.ASNDatabase != "" {
+		if err := mcstatus.SetASNDatabase(cfg.ASNDatabase); err != nil {
+			log.Fatalf("Failed to open ASN database: %v", err)
+		}
+		log.Printf("Using ASN database: %s", cfg.ASNDatabase)
+	}
+
+	// 設置功能開關，基岩版查詢的開關需要同步到查詢服務
+	if err := feature.Configure(cfg.FeatureFlags); err != nil {
+		log.Fatalf("Failed to configure feature flags: %v", err)
+	}
+	feature.Watch(feature.Bedrock, mcstatus.SetBedrockEnabled)
+	for _, f := range feature.List() {
+		if !f.Enabled {
+			log.Printf("Feature %s is disabled", f.Name)
+		}
+	}
+
+	// 註冊外部擴展，自定義的 Go 擴展也應在這裡通過 enrich.Register 註冊
+	enrich.SetTimeout(cfg.EnricherTimeout)
+	if err := enrich.Configure(cfg.Enrichers); err != nil {
+		log.Fatalf("Failed to configure enrichers: %v", err)
+	}
+	if names := enrich.Names(); len(names) > 0 {
+		log.Printf("Status enrichers: %v", names)
+	}
+
+	// 啟動模擬伺服器，前端開發和集成測試不需要真實的 Minecraft 伺服器
+	if *mock {
+		var m mcmock.Server
+		if err := m.Start(cfg.MockJavaAddress, cfg.MockBedrockAddress); err != nil {
+			log.Fatalf("Failed to start mock servers: %v", err)
+		}
+		log.Printf("Mock Java server listening on %s, mock Bedrock server on %s", m.JavaAddr(), m.BedrockAddr())
+	}
+
+	// 設置管理員令牌
+	handlers.SetAdminToken(cfg.AdminToken)
+	handlers.SetRefreshAdminOnly(cfg.RefreshAdminOnly)
+
+	// 設置多區域查詢
+	if err := handlers.SetProbeRegions(cfg.Region, cfg.ProbeRegions); err != nil {
+		log.Fatalf("Failed to configure probe regions: %v", err)
+	}
+	if len(cfg.ProbeRegions) > 0 {
+		log.Printf("Probing from region %s and %d other regions", cfg.Region, len(cfg.ProbeRegions))
+	}
+
+	// 啟動 gRPC 服務
+	if cfg.GRPCPort != "" {
+		go func() {
+			log.Printf("gRPC server starting on port %s", cfg.GRPCPort)
+			if err := rpc.ListenAndServe(":" + cfg.GRPCPort); err != nil {
+				log.Fatalf("Failed to start gRPC server: %v", err)
+			}
+		}()
 	}
-	gin.SetMode(ginMode)
-	log.Printf("Gin mode: %s", ginMode)
 
 	// 創建 gin 引擎
-	r := gin.Default()
+	r := gin.New()
+	r.UseH2C = cfg.HTTP2Cleartext
+	r.Use(gin.Logger())
+	if cfg.CompressResponses {
+		r.Use(handlers.Compress())
+	}
+	r.Use(handlers.RequestID(), handlers.Recovery())
 
 	// 設置路由
 	api.SetupRoutes(r)
-	log.Println("Routes set up successfully")
-
-	// 獲取端口
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080" // 默認端口
+	if cfg.ServeFrontend {
+		web.Register(r, cfg.FrontendServers)
+		log.Println("Serving embedded dashboard at /")
 	}
+	log.Println("Routes set up successfully")
 
 	// 啟動服務器
-	log.Printf("Server starting on port %s", port)
-	if err := r.Run(":" + port); err != nil {
+	err := server.ListenAndServe(r.Handler(), server.Options{
+		Port:           cfg.Port,
+		TLSDomains:     cfg.TLSDomains,
+		TLSPort:        cfg.TLSPort,
+		TLSCacheDir:    cfg.TLSCacheDir,
+		TLSEmail:       cfg.TLSEmail,
+		UnixSocket:     cfg.UnixSocket,
+		UnixSocketMode: cfg.UnixSocketMode,
+	})
+	if err != nil {
 		log.Fatalf("Failed to start server: %v", err)
 	}
 }
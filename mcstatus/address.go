@@ -0,0 +1,74 @@
+package mcstatus
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// addressScheme 是 Minecraft 客戶端和伺服器列表網站使用的地址前綴
+const addressScheme = "minecraft://"
+
+// NormalizeAddress 在發起任何網絡連接之前規範化用戶輸入的伺服器地址
+// 接受 host、host:port、[IPv6]:port 及 minecraft://host:port，去除首尾空白和結尾的 /，
+// 將國際化域名轉換為 punycode 並統一為小寫，返回 "主機" 或 "主機:端口"
+func NormalizeAddress(raw string) (string, error) {
+	address := strings.TrimSpace(raw)
+	if len(address) >= len(addressScheme) && strings.EqualFold(address[:len(addressScheme)], addressScheme) {
+		address = address[len(addressScheme):]
+	} else if strings.Contains(address, "://") {
+		return "", newError(CodeInvalidAddress, "不支援的地址協議", nil)
+	}
+	address = strings.TrimRight(address, "/")
+	if address == "" {
+		return "", newError(CodeInvalidAddress, "伺服器地址不能為空", nil)
+	}
+
+	host, port := address, ""
+	switch {
+	case strings.HasPrefix(address, "["):
+		// 方括號中的 IPv6 地址，可帶端口
+		h, p, err := net.SplitHostPort(address)
+		if err != nil {
+			if !strings.HasSuffix(address, "]") {
+				return "", newError(CodeInvalidAddress, "無效的伺服器地址", err)
+			}
+			h = address[1 : len(address)-1]
+		}
+		host, port = h, p
+		if ip := net.ParseIP(host); ip == nil || ip.To4() != nil {
+			return "", newError(CodeInvalidAddress, "無效的 IPv6 地址", nil)
+		}
+	case strings.Count(address, ":") > 1:
+		// 不帶方括號的 IPv6 地址，無法指定端口
+		if net.ParseIP(address) == nil {
+			return "", newError(CodeInvalidAddress, "無效的 IPv6 地址", nil)
+		}
+	case strings.Contains(address, ":"):
+		host, port, _ = strings.Cut(address, ":")
+		if port == "" {
+			return "", newError(CodeInvalidAddress, "無效的端口", nil)
+		}
+	}
+
+	if port != "" {
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			return "", newError(CodeInvalidAddress, "無效的端口", err)
+		}
+	}
+
+	if net.ParseIP(host) == nil {
+		ascii, err := idna.Lookup.ToASCII(strings.TrimSuffix(host, "."))
+		if err != nil || ascii == "" {
+			return "", newError(CodeInvalidAddress, "無效的主機名", err)
+		}
+		host = ascii
+	}
+
+	if port == "" {
+		return host, nil
+	}
+	return net.JoinHostPort(host, port), nil
+}
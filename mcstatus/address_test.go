@@ -0,0 +1,54 @@
+package mcstatus
+
+import "testing"
+
+func TestNormalizeAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{name: "主機名", raw: "play.example.com", want: "play.example.com"},
+		{name: "主機名和端口", raw: "play.example.com:25565", want: "play.example.com:25565"},
+		{name: "大寫", raw: "Play.Example.COM:25566", want: "play.example.com:25566"},
+		{name: "首尾空白和結尾的斜線", raw: "  play.example.com/ ", want: "play.example.com"},
+		{name: "結尾的點", raw: "play.example.com.", want: "play.example.com"},
+		{name: "minecraft 協議", raw: "minecraft://play.example.com:25565/", want: "play.example.com:25565"},
+		{name: "大寫的 minecraft 協議", raw: "MINECRAFT://play.example.com", want: "play.example.com"},
+		{name: "國際化域名", raw: "例子.測試:25565", want: "xn--fsqu00a.xn--g6w251d:25565"},
+		{name: "IPv4", raw: "127.0.0.1:25565", want: "127.0.0.1:25565"},
+		{name: "IPv6", raw: "::1", want: "::1"},
+		{name: "方括號中的 IPv6", raw: "[::1]", want: "::1"},
+		{name: "方括號中的 IPv6 和端口", raw: "[2001:db8::1]:25565", want: "[2001:db8::1]:25565"},
+
+		{name: "空地址", raw: "   ", wantErr: true},
+		{name: "只有斜線", raw: "/", wantErr: true},
+		{name: "其他協議", raw: "http://play.example.com", wantErr: true},
+		{name: "空端口", raw: "play.example.com:", wantErr: true},
+		{name: "端口為 0", raw: "play.example.com:0", wantErr: true},
+		{name: "端口過大", raw: "play.example.com:65536", wantErr: true},
+		{name: "非數字端口", raw: "play.example.com:abc", wantErr: true},
+		{name: "無效的 IPv6", raw: "1:2:3", wantErr: true},
+		{name: "方括號中的 IPv4", raw: "[127.0.0.1]:25565", wantErr: true},
+		{name: "方括號中的 IPv6 帶無效端口", raw: "[::1]:99999", wantErr: true},
+		{name: "包含空白的主機名", raw: "play example.com", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeAddress(tt.raw)
+			if tt.wantErr {
+				if ErrorCodeOf(err) != CodeInvalidAddress {
+					t.Fatalf("NormalizeAddress(%q) = %q, %v，應返回 %s 錯誤", tt.raw, got, err, CodeInvalidAddress)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NormalizeAddress(%q) 返回錯誤: %v", tt.raw, err)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeAddress(%q) = %q，應為 %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package mcstatus
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/png"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/image/draw"
+)
+
+// 皮膚圖片中頭部正面和帽子層的位置
+var (
+	skinFace    = image.Rect(8, 8, 16, 16)
+	skinHatFace = image.Rect(40, 8, 48, 16)
+)
+
+// maxCachedFaces 是頭像緩存的最大條目數，超出時清空緩存
+const maxCachedFaces = 1000
+
+// cachedFace 緩存的 8×8 頭像
+type cachedFace struct {
+	face    *image.NRGBA
+	expires time.Time
+}
+
+var (
+	facesMu sync.Mutex
+	faces   = make(map[string]cachedFace)
+)
+
+// GetPlayerAvatar 返回玩家皮膚的頭部正面（包含帽子層），縮放到 size×size 並編碼為 PNG
+// uuid 必須是正版玩家的 UUID
+func GetPlayerAvatar(ctx context.Context, uuid string, size int) ([]byte, error) {
+	id, ok := onlineUUID(uuid)
+	if !ok {
+		return nil, newError(CodePlayerNotFound, "不是正版玩家的 UUID", nil)
+	}
+
+	face, err := playerFace(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 使用最近鄰插值以保持像素風格
+	dst := image.NewNRGBA(image.Rect(0, 0, size, size))
+	draw.NearestNeighbor.Scale(dst, dst.Bounds(), face, face.Bounds(), draw.Src, nil)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, dst); err != nil {
+		return nil, fmt.Errorf("編碼頭像失敗: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// playerFace 返回玩家的 8×8 頭像，優先使用緩存
+func playerFace(ctx context.Context, id string) (*image.NRGBA, error) {
+	now := time.Now()
+	facesMu.Lock()
+	entry, ok := faces[id]
+	facesMu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.face, nil
+	}
+
+	profile, err := profiles.lookup(ctx, id)
+	if err != nil {
+		return nil, newError(CodeProfileUnavailable, "獲取玩家資料失敗", err)
+	}
+	if profile == nil {
+		return nil, newError(CodePlayerNotFound, "玩家不存在", nil)
+	}
+	if profile.SkinURL == "" {
+		return nil, newError(CodePlayerNotFound, "玩家沒有自定義皮膚", nil)
+	}
+
+	skin, err := fetchSkin(ctx, profile.SkinURL)
+	if err != nil {
+		return nil, newError(CodeProfileUnavailable, "下載玩家皮膚失敗", err)
+	}
+
+	face := image.NewNRGBA(image.Rect(0, 0, skinFace.Dx(), skinFace.Dy()))
+	draw.Draw(face, face.Bounds(), skin, skinFace.Min, draw.Src)
+	draw.Draw(face, face.Bounds(), skin, skinHatFace.Min, draw.Over)
+
+	facesMu.Lock()
+	if len(faces) >= maxCachedFaces {
+		faces = make(map[string]cachedFace)
+	}
+	faces[id] = cachedFace{face: face, expires: now.Add(profileCacheTTL)}
+	facesMu.Unlock()
+	return face, nil
+}
+
+// fetchSkin 下載並解碼皮膚圖片
+func fetchSkin(ctx context.Context, url string) (image.Image, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := profileClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("皮膚伺服器返回 %s", resp.Status)
+	}
+
+	skin, err := png.Decode(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if b := skin.Bounds(); b.Dx() < skinHatFace.Max.X || b.Dy() < skinHatFace.Max.Y {
+		return nil, fmt.Errorf("皮膚尺寸無效: %d×%d", b.Dx(), b.Dy())
+	}
+	return skin, nil
+}
@@ -0,0 +1,173 @@
+package mcstatus
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"time"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// 橫幅的尺寸和佈局
+const (
+	bannerWidth    = 468
+	bannerHeight   = 60
+	bannerPadding  = 6
+	bannerIconSize = 48
+	bannerFontSize = 12
+	bannerMOTDRows = 2
+)
+
+// 橫幅使用的顏色
+var (
+	bannerBackground = color.RGBA{0x1e, 0x1e, 0x1e, 0xff}
+	bannerTitleColor = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	bannerTextColor  = color.RGBA{0xaa, 0xaa, 0xaa, 0xff}
+	bannerIconColor  = color.RGBA{0x40, 0x40, 0x40, 0xff}
+	bannerGreen      = color.RGBA{0x55, 0xff, 0x55, 0xff}
+	bannerYellow     = color.RGBA{0xff, 0xff, 0x55, 0xff}
+	bannerRed        = color.RGBA{0xff, 0x55, 0x55, 0xff}
+)
+
+// 橫幅使用的字體，每次渲染時創建新的 Face，因為 Face 不能並發使用
+var (
+	regularFont = mustParseFont(goregular.TTF)
+	boldFont    = mustParseFont(gobold.TTF)
+)
+
+// Banner 是渲染狀態橫幅所需的數據
+type Banner struct {
+	Title      string          // 標題，一般為伺服器地址
+	Favicon    string          // 伺服器圖標（data URL），為空時顯示佔位方塊
+	MOTD       []MOTDComponent // 伺服器描述，只顯示前兩行
+	Online     bool            // 伺服器是否在線
+	Players    int             // 在線人數
+	MaxPlayers int             // 最大人數
+	Latency    time.Duration   // 連接延遲
+}
+
+// RenderBanner 將伺服器狀態渲染為 468×60 的 PNG 橫幅
+// 使用的 Go 字體只包含拉丁字母等字符，其他文字會顯示為方塊
+func RenderBanner(b Banner) ([]byte, error) {
+	img := image.NewRGBA(image.Rect(0, 0, bannerWidth, bannerHeight))
+	draw.Draw(img, img.Bounds(), image.NewUniform(bannerBackground), image.Point{}, draw.Src)
+
+	regular, err := opentype.NewFace(regularFont, &opentype.FaceOptions{Size: bannerFontSize, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		return nil, err
+	}
+	defer regular.Close()
+	bold, err := opentype.NewFace(boldFont, &opentype.FaceOptions{Size: bannerFontSize, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		return nil, err
+	}
+	defer bold.Close()
+
+	drawBannerIcon(img, b.Favicon)
+
+	// 右上角顯示在線人數和延遲，離線時只顯示 offline
+	textX := bannerPadding*2 + bannerIconSize
+	right := bannerWidth - bannerPadding
+	baseline := bannerPadding + bannerFontSize
+	if b.Online {
+		latency := fmt.Sprintf("%d ms", b.Latency.Milliseconds())
+		right = drawTextRight(img, regular, latencyColor(b.Latency), latency, right, baseline) - bannerPadding
+		right = drawTextRight(img, bold, bannerTitleColor, fmt.Sprintf("%d/%d", b.Players, b.MaxPlayers), right, baseline)
+	} else {
+		right = drawTextRight(img, bold, bannerRed, "offline", right, baseline)
+	}
+
+	// 標題超出可用寬度時截斷
+	title := img.SubImage(image.Rect(textX, 0, right-bannerPadding, bannerHeight)).(*image.RGBA)
+	drawText(title, bold, bannerTitleColor, b.Title, textX, baseline)
+
+	// 按原有的顏色和格式繪製 MOTD
+	if b.Online {
+		for i, line := range SplitLines(b.MOTD) {
+			if i == bannerMOTDRows {
+				break
+			}
+			x := textX
+			y := baseline + (i+1)*(bannerFontSize+4)
+			for _, c := range line {
+				face := regular
+				if c.Bold {
+					face = bold
+				}
+				x = drawText(img, face, componentColor(c.Color), c.Text, x, y)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("編碼橫幅失敗: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// drawBannerIcon 在橫幅左側繪製縮放後的伺服器圖標，圖標無效或不是 64×64 時繪製佔位方塊
+func drawBannerIcon(img *image.RGBA, favicon string) {
+	rect := image.Rect(bannerPadding, bannerPadding, bannerPadding+bannerIconSize, bannerPadding+bannerIconSize)
+	if data, err := DecodeFavicon(favicon); err == nil {
+		if icon, err := decodeFaviconImage(data); err == nil {
+			draw.CatmullRom.Scale(img, rect, icon, icon.Bounds(), draw.Over, nil)
+			return
+		}
+	}
+	draw.Draw(img, rect, image.NewUniform(bannerIconColor), image.Point{}, draw.Src)
+}
+
+// drawText 從 (x, y) 開始繪製文本，返回繪製結束的 x 坐標
+func drawText(img *image.RGBA, face font.Face, c color.Color, text string, x, y int) int {
+	d := &font.Drawer{Dst: img, Src: image.NewUniform(c), Face: face, Dot: fixed.P(x, y)}
+	d.DrawString(text)
+	return d.Dot.X.Ceil()
+}
+
+// drawTextRight 繪製右端對齊 right 的文本，返回文本開始的 x 坐標
+func drawTextRight(img *image.RGBA, face font.Face, c color.Color, text string, right, y int) int {
+	x := right - font.MeasureString(face, text).Ceil()
+	drawText(img, face, c, text, x, y)
+	return x
+}
+
+// componentColor 返回 MOTD 片段的顏色，沒有顏色時使用默認的灰色
+func componentColor(name string) color.Color {
+	var r, g, b uint8
+	if hex := resolveColor(name); hex != "" {
+		if _, err := fmt.Sscanf(hex, "#%02x%02x%02x", &r, &g, &b); err == nil {
+			return color.RGBA{r, g, b, 0xff}
+		}
+	}
+	return bannerTextColor
+}
+
+// latencyColor 根據延遲選擇顏色
+func latencyColor(latency time.Duration) color.Color {
+	switch {
+	case latency < 100*time.Millisecond:
+		return bannerGreen
+	case latency < 300*time.Millisecond:
+		return bannerYellow
+	default:
+		return bannerRed
+	}
+}
+
+// mustParseFont 解析內置字體，失敗時直接 panic
+func mustParseFont(data []byte) *opentype.Font {
+	f, err := opentype.Parse(data)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
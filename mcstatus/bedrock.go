@@ -0,0 +1,273 @@
+package mcstatus
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultBedrockPort 是基岩版伺服器的默認端口
+const DefaultBedrockPort = "19132"
+
+// bedrockDisabled 為 true 時拒絕所有基岩版查詢
+var bedrockDisabled atomic.Bool
+
+// errBedrockDisabled 是基岩版查詢停用時返回的錯誤
+var errBedrockDisabled = newError(CodeBedrockDisabled, "基岩版查詢已停用", nil)
+
+// SetBedrockEnabled 設置是否允許查詢基岩版伺服器（默認允許）
+// 停用時基岩版查詢返回 CodeBedrockDisabled 錯誤，自動判斷版本時只查詢 Java 版
+func SetBedrockEnabled(enabled bool) {
+	bedrockDisabled.Store(!enabled)
+}
+
+// maxDatagramSize 是接收 Pong 數據包的緩衝區大小
+const maxDatagramSize = 2048
+
+// raknetMagic 是 RakNet 離線消息中固定的魔數
+var raknetMagic = []byte{0x00, 0xff, 0xff, 0x00, 0xfe, 0xfe, 0xfe, 0xfe, 0xfd, 0xfd, 0xfd, 0xfd, 0x12, 0x34, 0x56, 0x78}
+
+// RakNet 離線消息的數據包 ID
+const (
+	unconnectedPingID = 0x01
+	unconnectedPongID = 0x1c
+)
+
+// BedrockStatus 定義了從基岩版伺服器接收到的狀態信息結構
+type BedrockStatus struct {
+	GameType       string          `json:"game_type"`                 // MCPE（基岩版）或 MCEE（教育版）
+	MOTD           string          `json:"motd"`                      // 伺服器描述第一行
+	MOTDComponents []MOTDComponent `json:"motd_components,omitempty"` // 解析 § 格式代碼後的描述
+	Protocol       int             `json:"protocol"`                  // 伺服器協議版本
+	Version        string          `json:"version"`                   // 伺服器版本名稱
+	Players        struct {
+		Online int `json:"online"`
+		Max    int `json:"max"`
+	} `json:"players"`
+	ServerID string `json:"server_id,omitempty"` // 伺服器唯一 ID
+	MapName  string `json:"map_name,omitempty"`  // 伺服器描述第二行（通常為地圖名稱）
+	GameMode string `json:"gamemode,omitempty"`  // 遊戲模式
+	PortIPv4 int    `json:"port_ipv4,omitempty"` // IPv4 端口
+	PortIPv6 int    `json:"port_ipv6,omitempty"` // IPv6 端口
+
+	Location *GeoLocation `json:"location,omitempty"` // 伺服器 IP 的地理位置（僅在載入 GeoIP 數據庫時提供）
+	Network  *NetworkInfo `json:"network,omitempty"`  // 伺服器 IP 所屬的自治系統（僅在擴展模式下提供）
+
+	// 連接信息，不包含在 JSON 回應中
+	IP        string        `json:"-"` // 實際連接的 IP 地址
+	Port      int           `json:"-"` // 實際連接的端口
+	Latency   time.Duration `json:"-"` // Ping 的往返時間
+	QueriedAt time.Time     `json:"-"` // 開始查詢的時間
+	Duration  time.Duration `json:"-"` // 從解析地址到收到 Pong 的總時間
+}
+
+// GetBedrockStatus 查詢指定地址的基岩版伺服器狀態
+func GetBedrockStatus(address string, opts QueryOptions) (*BedrockStatus, error) {
+	return GetBedrockStatusContext(context.Background(), address, opts)
+}
+
+// GetBedrockStatusContext 查詢指定地址的基岩版伺服器狀態，ctx 被取消時中止查詢
+func GetBedrockStatusContext(ctx context.Context, address string, opts QueryOptions) (*BedrockStatus, error) {
+	if bedrockDisabled.Load() {
+		return nil, errBedrockDisabled
+	}
+	address, err := NormalizeAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	status, err := getBedrockStatus(ctx, address, opts)
+	targets.record(EditionBedrock, address, err)
+	return status, err
+}
+
+// getBedrockStatus 實際查詢基岩版伺服器狀態
+func getBedrockStatus(ctx context.Context, address string, opts QueryOptions) (*BedrockStatus, error) {
+	log.Printf("開始查詢基岩版伺服器狀態: %s", address)
+	queryStart := time.Now()
+	defer inflight.track(EditionBedrock, address, opts.Client)()
+	trace, done := watchSlowQuery(EditionBedrock, address, opts.Trace)
+	defer done()
+
+	host, port, _ := splitAddress(address, DefaultBedrockPort)
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return nil, newError(CodeInvalidAddress, "無效的端口", err)
+	}
+
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	lookup := dnsLookup
+	if opts.Resolver != nil {
+		lookup = newDNSCache(systemResolver{opts.Resolver}, 0)
+	}
+
+	phaseStart := time.Now()
+	ips, cached, err := lookup.lookupIP(ctx, host)
+	trace.phase("dns", phaseStart, err)
+	if err != nil {
+		if errors.Is(err, errNoRecords) {
+			return nil, newError(CodeDNSFailure, "無法找到 IP 地址", nil)
+		}
+		return nil, newError(CodeDNSFailure, "無法解析主機名", err)
+	}
+	log.Printf("解析到的 IP: %v（來自緩存: %v）", ips, cached)
+	trace.dns("", false, ips, cached)
+
+	bind := bindAddress
+	if opts.BindAddress != "" {
+		bind = opts.BindAddress
+	}
+
+	release, err := limiter.acquire(ctx, strings.ToLower(net.JoinHostPort(host, port)))
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// UDP 沒有連接階段，依次向每個地址發送 Ping，直到收到回應
+	var lastErr error
+	for _, ip := range sortAddresses(ips) {
+		start := time.Now()
+		status, err := pingBedrock(ctx, ip, port, bind, opts.Dialer, trace)
+		if err == nil {
+			status.Latency = time.Since(start)
+			status.QueriedAt = queryStart
+			status.Duration = time.Since(queryStart)
+			status.IP = ip.String()
+			status.Port, _ = strconv.Atoi(port)
+			status.Location = lookupLocation(status.IP)
+			return status, nil
+		}
+		log.Printf("基岩版 Ping %s 失敗: %v", ip, err)
+		lastErr = err
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, newError(ErrorCodeOf(lastErr), "查詢基岩版伺服器失敗", lastErr)
+}
+
+// pingBedrock 向指定地址發送 RakNet Unconnected Ping 並解析回應
+// custom 不為 nil 時使用它建立 UDP 連接，此時忽略 bind
+func pingBedrock(ctx context.Context, ip net.IP, port, bind string, custom Dialer, trace *DebugTrace) (*BedrockStatus, error) {
+	dialer := custom
+	if dialer == nil {
+		d := &net.Dialer{}
+		if bind != "" {
+			localIP, err := resolveBindAddress(bind)
+			if err != nil {
+				return nil, newError(CodeInvalidBindAddress, "設置出站連接失敗", err)
+			}
+			d.LocalAddr = &net.UDPAddr{IP: localIP}
+		}
+		dialer = d
+	}
+
+	phaseStart := time.Now()
+	conn, err := dialer.DialContext(ctx, "udp", net.JoinHostPort(ip.String(), port))
+	trace.phase("dial", phaseStart, err)
+	if err != nil {
+		return nil, newError(classifyDialError(err), "連接伺服器失敗", err)
+	}
+	defer conn.Close()
+	conn = trace.wrap(conn)
+
+	// 每個地址最多等待 2 秒，且不超過整體的超時時間
+	deadline := time.Now().Add(2 * time.Second)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	conn.SetDeadline(deadline)
+
+	phaseStart = time.Now()
+	var ping bytes.Buffer
+	ping.WriteByte(unconnectedPingID)
+	binary.Write(&ping, binary.BigEndian, time.Now().UnixMilli())
+	ping.Write(raknetMagic)
+	binary.Write(&ping, binary.BigEndian, rand.Int63())
+	if _, err := conn.Write(ping.Bytes()); err != nil {
+		return nil, newError(classifyReadError(err), "發送 Ping 數據包失敗", err)
+	}
+
+	readBuf := getReadBuffer()
+	defer putReadBuffer(readBuf)
+	readBuf.Grow(maxDatagramSize)
+	buf := readBuf.AvailableBuffer()[:maxDatagramSize]
+	n, err := conn.Read(buf)
+	trace.phase("read", phaseStart, err)
+	if err != nil {
+		return nil, newError(classifyReadError(err), "讀取回應失敗", err)
+	}
+	status, err := parseBedrockPong(buf[:n])
+	if err != nil {
+		return nil, newError(CodeInvalidPacket, "解析 Pong 數據包失敗", err)
+	}
+	return status, nil
+}
+
+// parseBedrockPong 解析 RakNet Unconnected Pong 數據包
+// 格式：ID(1) + 時間(8) + 伺服器 GUID(8) + 魔數(16) + 字符串長度(2) + 伺服器信息
+func parseBedrockPong(data []byte) (*BedrockStatus, error) {
+	const headerLen = 1 + 8 + 8 + 16 + 2
+	if len(data) < headerLen || data[0] != unconnectedPongID {
+		return nil, errors.New("無效的 Pong 數據包")
+	}
+	if !bytes.Equal(data[17:33], raknetMagic) {
+		return nil, errors.New("Pong 數據包的魔數不正確")
+	}
+	length := int(binary.BigEndian.Uint16(data[33:35]))
+	if len(data) < headerLen+length {
+		return nil, errors.New("Pong 數據包長度不足")
+	}
+
+	// 伺服器信息以分號分隔：版本;描述;協議;版本名稱;在線人數;最大人數;伺服器ID;地圖名稱;遊戲模式;遊戲模式數值;IPv4端口;IPv6端口
+	fields := strings.Split(string(data[headerLen:headerLen+length]), ";")
+	if len(fields) < 6 {
+		return nil, fmt.Errorf("伺服器信息字段不足: %d", len(fields))
+	}
+	field := func(i int) string {
+		if i < len(fields) {
+			return fields[i]
+		}
+		return ""
+	}
+
+	status := &BedrockStatus{
+		GameType: field(0),
+		MOTD:     field(1),
+		Version:  field(3),
+		ServerID: field(6),
+		MapName:  field(7),
+		GameMode: field(8),
+	}
+	status.Protocol, _ = strconv.Atoi(field(2))
+	status.Players.Online, _ = strconv.Atoi(field(4))
+	status.Players.Max, _ = strconv.Atoi(field(5))
+	status.PortIPv4, _ = strconv.Atoi(field(10))
+	status.PortIPv6, _ = strconv.Atoi(field(11))
+	status.MOTDComponents = ParseLegacyText(status.MOTD)
+
+	return status, nil
+}
+
+// splitAddress 將地址拆分為主機和端口，未指定端口時使用 defaultPort
+func splitAddress(address, defaultPort string) (host, port string, hasPort bool) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return address, defaultPort, false
+	}
+	return host, port, true
+}
@@ -0,0 +1,159 @@
+package mcstatus
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// blockedServersTTL 是 Mojang 封鎖伺服器列表的緩存時間
+const blockedServersTTL = time.Hour
+
+// blockedServersRetry 是下載封鎖列表失敗後再次嘗試之前的等待時間，避免 Mojang 無法訪問時每個請求都重新下載
+const blockedServersRetry = time.Minute
+
+// blockedServersURL 是 Mojang 封鎖伺服器列表的地址，每行一個 SHA-1 哈希值
+var blockedServersURL = "https://sessionserver.mojang.com/blockedservers"
+
+var blockedServersClient = &http.Client{Timeout: 5 * time.Second}
+
+// blockedServers 緩存 Mojang 封鎖伺服器列表
+type blockedServers struct {
+	mu       sync.Mutex
+	hashes   map[string]bool
+	err      error         // 最近一次下載失敗的原因，下載成功後清除
+	expires  time.Time     // 需要重新下載的時間，下載失敗時為下次重試的時間
+	fetching chan struct{} // 正在下載時不為 nil，下載結束時關閉
+}
+
+var blocklist = &blockedServers{}
+
+// IsBlockedByMojang 檢查地址是否在 Mojang 的封鎖列表中，與客戶端一樣同時檢查主機名和實際連接的 IP
+// 列表中保存的是小寫主機名或通配符模式（例如 *.example.com、1.2.3.*）的 SHA-1 哈希值，無法獲取列表時返回 nil
+func IsBlockedByMojang(ctx context.Context, address, ip string) *bool {
+	hashes, err := blocklist.get(ctx)
+	if err != nil {
+		log.Printf("獲取 Mojang 封鎖列表失敗: %v", err)
+		return nil
+	}
+	host, _, _ := splitAddress(address, "")
+	blocked := false
+	for _, name := range []string{host, ip} {
+		for _, pattern := range blockedPatterns(name) {
+			sum := sha1.Sum([]byte(pattern))
+			if hashes[hex.EncodeToString(sum[:])] {
+				blocked = true
+			}
+		}
+	}
+	return &blocked
+}
+
+// blockedPatterns 返回 Mojang 客戶端檢查的所有模式
+// 域名從左邊逐級替換為 *，IPv4 地址從右邊逐段替換為 *
+func blockedPatterns(name string) []string {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if name == "" {
+		return nil
+	}
+	patterns := []string{name}
+	if ip := net.ParseIP(name); ip != nil {
+		if ip.To4() == nil {
+			return patterns
+		}
+		parts := strings.Split(name, ".")
+		for i := len(parts) - 1; i > 0; i-- {
+			patterns = append(patterns, strings.Join(parts[:i], ".")+".*")
+		}
+		return patterns
+	}
+	parts := strings.Split(name, ".")
+	for i := 1; i < len(parts); i++ {
+		patterns = append(patterns, "*."+strings.Join(parts[i:], "."))
+	}
+	return patterns
+}
+
+// get 返回封鎖列表，緩存過期時在後台重新下載，下載期間和下載失敗時繼續使用舊的列表
+// 只有還沒有任何列表時才等待下載完成，等待的時間受 ctx 限制
+func (b *blockedServers) get(ctx context.Context) (map[string]bool, error) {
+	b.mu.Lock()
+	if b.fetching == nil && !time.Now().Before(b.expires) {
+		b.fetching = make(chan struct{})
+		go b.refresh(b.fetching)
+	}
+	hashes, err, fetching := b.hashes, b.err, b.fetching
+	b.mu.Unlock()
+
+	if hashes != nil {
+		return hashes, nil
+	}
+	if fetching == nil {
+		return nil, err
+	}
+	select {
+	case <-fetching:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.hashes == nil {
+		return nil, b.err
+	}
+	return b.hashes, nil
+}
+
+// refresh 下載封鎖列表並更新緩存，結束時關閉 done
+// 下載不受單個請求的 ctx 影響，時限由 blockedServersClient 控制
+func (b *blockedServers) refresh(done chan struct{}) {
+	hashes, err := fetchBlockedServers(context.Background())
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err != nil {
+		b.err = err
+		b.expires = time.Now().Add(blockedServersRetry)
+	} else {
+		b.hashes, b.err = hashes, nil
+		b.expires = time.Now().Add(blockedServersTTL)
+	}
+	b.fetching = nil
+	close(done)
+}
+
+// fetchBlockedServers 下載 Mojang 封鎖伺服器列表
+func fetchBlockedServers(ctx context.Context) (map[string]bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, blockedServersURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := blockedServersClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("封鎖列表伺服器返回 %s", resp.Status)
+	}
+
+	hashes := make(map[string]bool)
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			hashes[strings.ToLower(line)] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("讀取封鎖列表失敗: %w", err)
+	}
+	return hashes, nil
+}
@@ -0,0 +1,97 @@
+package mcstatus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// serveBlocklist 讓 blockedServersURL 指向測試伺服器，返回請求次數
+func serveBlocklist(t *testing.T, handler http.HandlerFunc) *atomic.Int32 {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		handler(w, r)
+	}))
+	original := blockedServersURL
+	blockedServersURL = srv.URL
+	t.Cleanup(func() {
+		blockedServersURL = original
+		srv.Close()
+	})
+	return &hits
+}
+
+// waitRefresh 等待後台的下載結束，避免下載在測試恢復 blockedServersURL 之後仍在進行
+func waitRefresh(b *blockedServers) {
+	b.mu.Lock()
+	fetching := b.fetching
+	b.mu.Unlock()
+	if fetching != nil {
+		<-fetching
+	}
+}
+
+func TestBlockedServersRetryAfterFailure(t *testing.T) {
+	hits := serveBlocklist(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	})
+	b := &blockedServers{}
+	defer waitRefresh(b)
+	for i := 0; i < 3; i++ {
+		if _, err := b.get(context.Background()); err == nil {
+			t.Fatal("下載失敗時應返回錯誤")
+		}
+	}
+	if n := hits.Load(); n != 1 {
+		t.Errorf("下載失敗後在重試時間內請求了 %d 次，應只請求 1 次", n)
+	}
+}
+
+func TestBlockedServersServeStaleWhileRefreshing(t *testing.T) {
+	release := make(chan struct{})
+	hits := serveBlocklist(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write([]byte("0123456789abcdef0123456789abcdef01234567\n"))
+	})
+	stale := map[string]bool{"stale": true}
+	b := &blockedServers{hashes: stale, expires: time.Now().Add(-time.Second)}
+	defer waitRefresh(b)
+	defer close(release)
+	for i := 0; i < 3; i++ {
+		start := time.Now()
+		hashes, err := b.get(context.Background())
+		if err != nil || !hashes["stale"] {
+			t.Fatalf("刷新期間應返回舊的列表，實際返回 %v, %v", hashes, err)
+		}
+		if d := time.Since(start); d > time.Second {
+			t.Fatalf("刷新期間等待了 %v", d)
+		}
+	}
+	// 等待唯一的刷新請求到達
+	for deadline := time.Now().Add(time.Second); hits.Load() == 0 && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if n := hits.Load(); n != 1 {
+		t.Errorf("刷新期間請求了 %d 次，應只請求 1 次", n)
+	}
+}
+
+func TestBlockedServersWaitBoundedByContext(t *testing.T) {
+	release := make(chan struct{})
+	serveBlocklist(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	})
+	b := &blockedServers{}
+	defer waitRefresh(b)
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := b.get(ctx); err != context.DeadlineExceeded {
+		t.Errorf("沒有列表時應等待到 ctx 結束，實際返回 %v", err)
+	}
+}
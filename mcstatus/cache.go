@@ -0,0 +1,338 @@
+package mcstatus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// statusCache 緩存不帶額外選項的查詢結果，ttl 為 0 時不緩存
+// 過期時間的含義與 RFC 5861 的 stale-while-revalidate 和 stale-if-error 相同
+type statusCache struct {
+	ttl                  time.Duration // 結果保持新鮮的時間
+	staleWhileRevalidate time.Duration // 過期後仍直接返回舊結果並在後台刷新的時間
+	staleIfError         time.Duration // 過期後查詢失敗時仍可返回舊結果的時間
+
+	mu        sync.Mutex
+	entries   map[string]*cacheEntry
+	lastPrune time.Time
+}
+
+// cacheEntry 是一個緩存的查詢結果
+type cacheEntry struct {
+	edition    string
+	address    string
+	status     *EditionStatus
+	stored     time.Time
+	hits       int
+	refreshing bool // 是否正在後台刷新，避免同時發起多個刷新
+}
+
+// CacheOptions 是使用緩存查詢時的選項
+type CacheOptions struct {
+	// Refresh 為 true 時忽略緩存的結果，直接查詢並更新緩存
+	Refresh bool
+	// StaleIfError 為 true 時，查詢失敗會返回過期不超過 staleIfError 的最後一次成功結果
+	StaleIfError bool
+	// Client 是發起查詢的客戶端，只用於列出正在進行的查詢
+	Client string
+}
+
+// CacheInfo 描述查詢結果與緩存的關係
+type CacheInfo struct {
+	Cached bool          // 結果是否來自緩存
+	Stale  bool          // 結果是否已經過期，即正在後台刷新，或查詢失敗時返回的最後一次成功結果
+	Age    time.Duration // 距離實際查詢的時間
+	TTL    time.Duration // 結果在緩存中保持新鮮的時間，未啟用緩存時為 0
+}
+
+var statuses = newStatusCache(0, 0, 0)
+
+// newStatusCache 創建一個狀態緩存
+func newStatusCache(ttl, staleWhileRevalidate, staleIfError time.Duration) *statusCache {
+	return &statusCache{
+		ttl:                  ttl,
+		staleWhileRevalidate: max(staleWhileRevalidate, 0),
+		staleIfError:         max(staleIfError, 0),
+		entries:              make(map[string]*cacheEntry),
+	}
+}
+
+// SetStatusCache 設置查詢結果的緩存時間，以及過期後仍可返回舊結果的時間，ttl <= 0 表示不緩存
+func SetStatusCache(ttl, staleWhileRevalidate, staleIfError time.Duration) {
+	statuses = newStatusCache(ttl, staleWhileRevalidate, staleIfError)
+}
+
+// GetCachedStatus 查詢伺服器狀態，只適用於不帶額外選項的查詢
+// 緩存的結果未過期時直接返回；過期不超過 staleWhileRevalidate 時立即返回舊結果，並在後台刷新
+// 返回的結果是緩存的副本，調用者可以修改
+func GetCachedStatus(edition, address string, opts CacheOptions) (*EditionStatus, CacheInfo, error) {
+	return GetCachedStatusContext(context.Background(), edition, address, opts)
+}
+
+// GetCachedStatusContext 與 GetCachedStatus 相同，需要實際查詢時 ctx 被取消會中止查詢
+// 後台刷新不受 ctx 影響，請求結束後仍會完成並更新緩存
+func GetCachedStatusContext(ctx context.Context, edition, address string, opts CacheOptions) (*EditionStatus, CacheInfo, error) {
+	if edition == EditionBedrock && bedrockDisabled.Load() {
+		return nil, CacheInfo{}, errBedrockDisabled
+	}
+	address, err := NormalizeAddress(address)
+	if err != nil {
+		return nil, CacheInfo{}, err
+	}
+	c := statuses
+	if c.ttl <= 0 {
+		status, err := queryEdition(ctx, edition, address, opts.Client)
+		return status, CacheInfo{}, err
+	}
+
+	key := cacheKey(edition, address)
+	now := time.Now()
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if ok && !opts.Refresh && now.Sub(entry.stored) < c.ttl+c.staleWhileRevalidate {
+		age := now.Sub(entry.stored)
+		stale := age >= c.ttl
+		if stale && !entry.refreshing {
+			entry.refreshing = true
+			go c.refresh(key, edition, address)
+		}
+		entry.hits++
+		status := entry.status.Clone()
+		c.mu.Unlock()
+		return status, CacheInfo{Cached: true, Stale: stale, Age: age, TTL: c.ttl}, nil
+	}
+	c.mu.Unlock()
+
+	status, err := queryEdition(ctx, edition, address, opts.Client)
+	if err == nil {
+		c.store(key, edition, address, status)
+		return status.Clone(), CacheInfo{TTL: c.ttl}, nil
+	}
+	if opts.StaleIfError {
+		if status, info, ok := c.lastKnownGood(key); ok {
+			return status, info, nil
+		}
+	}
+	return nil, CacheInfo{}, err
+}
+
+// prewarmConcurrency 是預熱緩存時同時查詢的最大伺服器數
+const prewarmConcurrency = 8
+
+// PrewarmStatusCache 在後台定期查詢指定的伺服器，使它們的緩存結果總是新鮮的，交互請求可以立即得到回應
+// targets 的每一項為伺服器地址（Java 版），或 "版本=地址"，例如 "bedrock=play.example.com:19132"
+// 地址與交互請求一樣經過 NormalizeAddress 規範化，使預熱的結果能被命中；任何一項無效時返回錯誤
+func PrewarmStatusCache(targets []string) error {
+	c := statuses
+	if c.ttl <= 0 {
+		return errors.New("預熱緩存需要啟用狀態緩存")
+	}
+
+	type target struct{ edition, address string }
+	parsed := make([]target, 0, len(targets))
+	for _, t := range targets {
+		edition, address, ok := strings.Cut(t, "=")
+		if !ok {
+			edition, address = EditionJava, t
+		}
+		if edition != EditionJava && edition != EditionBedrock && edition != "auto" {
+			return fmt.Errorf("不支援的伺服器版本: %s", edition)
+		}
+		address, err := NormalizeAddress(address)
+		if err != nil {
+			return fmt.Errorf("無效的預熱目標 %q: %w", t, err)
+		}
+		// 規範化後相同的目標只查詢一次
+		if slices.Contains(parsed, target{edition, address}) {
+			continue
+		}
+		parsed = append(parsed, target{edition, address})
+	}
+
+	// 在緩存過期前刷新，避免交互請求遇到過期的結果
+	interval := max(c.ttl/2, time.Second)
+	go func() {
+		for {
+			sem := make(chan struct{}, prewarmConcurrency)
+			var wg sync.WaitGroup
+			for _, t := range parsed {
+				wg.Add(1)
+				sem <- struct{}{}
+				go func() {
+					defer wg.Done()
+					defer func() { <-sem }()
+					c.refresh(cacheKey(t.edition, t.address), t.edition, t.address)
+				}()
+			}
+			wg.Wait()
+			time.Sleep(interval)
+		}
+	}()
+	return nil
+}
+
+// refresh 在後台重新查詢並更新緩存，查詢失敗時保留舊結果
+func (c *statusCache) refresh(key, edition, address string) {
+	status, err := queryEdition(context.Background(), edition, address, "")
+	if err != nil {
+		log.Printf("後台刷新 %s 的狀態失敗: %v", address, err)
+		c.mu.Lock()
+		if entry, ok := c.entries[key]; ok {
+			entry.refreshing = false
+		}
+		c.mu.Unlock()
+		return
+	}
+	c.store(key, edition, address, status)
+}
+
+// store 保存查詢結果，保留原有的命中次數，並定期清除已無法再返回的條目
+func (c *statusCache) store(key, edition, address string, status *EditionStatus) {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &cacheEntry{edition: edition, address: address, status: status, stored: now}
+	if old, ok := c.entries[key]; ok {
+		entry.hits = old.hits
+	}
+	c.entries[key] = entry
+
+	if now.Sub(c.lastPrune) < c.ttl {
+		return
+	}
+	c.lastPrune = now
+	for k, e := range c.entries {
+		if now.Sub(e.stored) >= c.retention() {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// lastKnownGood 返回過期不超過 staleIfError 的舊結果
+func (c *statusCache) lastKnownGood(key string) (*EditionStatus, CacheInfo, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, CacheInfo{}, false
+	}
+	age := time.Since(entry.stored)
+	if age >= c.ttl+c.staleIfError {
+		return nil, CacheInfo{}, false
+	}
+	entry.hits++
+	return entry.status.Clone(), CacheInfo{Cached: true, Stale: true, Age: age, TTL: c.ttl}, true
+}
+
+// cacheKey 返回查詢結果在緩存中的鍵
+func cacheKey(edition, address string) string {
+	return edition + "|" + strings.ToLower(address)
+}
+
+// retention 返回條目需要保留的時間
+func (c *statusCache) retention() time.Duration {
+	return c.ttl + max(c.staleWhileRevalidate, c.staleIfError)
+}
+
+// queryEdition 以默認選項查詢指定版本的伺服器狀態，edition 為 auto 時自動檢測版本
+func queryEdition(ctx context.Context, edition, address, client string) (*EditionStatus, error) {
+	var err error
+	opts := QueryOptions{Client: client}
+	status := &EditionStatus{Edition: edition}
+	switch edition {
+	case EditionJava:
+		status.Java, err = GetServerStatusContext(ctx, address, opts)
+	case EditionBedrock:
+		status.Bedrock, err = GetBedrockStatusContext(ctx, address, opts)
+	default:
+		status, err = GetStatusAutoEditionContext(ctx, address, opts)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// Clone 複製查詢結果，調用者會修改的字段（玩家樣本等）不與原結果共享
+func (s *EditionStatus) Clone() *EditionStatus {
+	c := *s
+	if s.Java != nil {
+		java := *s.Java
+		java.Players.Sample = slices.Clone(s.Java.Players.Sample)
+		c.Java = &java
+	}
+	if s.Bedrock != nil {
+		bedrock := *s.Bedrock
+		c.Bedrock = &bedrock
+	}
+	return &c
+}
+
+// CacheEntryInfo 是一個緩存條目的摘要
+type CacheEntryInfo struct {
+	Edition string        // 查詢時指定的版本
+	Address string        // 查詢的地址
+	Age     time.Duration // 距離實際查詢的時間
+	Hits    int           // 返回緩存結果的次數
+	Stale   bool          // 是否已經過期
+}
+
+// CacheEntries 返回所有緩存條目的摘要，按地址和版本排序
+func CacheEntries() []CacheEntryInfo {
+	c := statuses
+	now := time.Now()
+	c.mu.Lock()
+	entries := make([]CacheEntryInfo, 0, len(c.entries))
+	for _, e := range c.entries {
+		age := now.Sub(e.stored)
+		entries = append(entries, CacheEntryInfo{Edition: e.edition, Address: e.address, Age: age, Hits: e.hits, Stale: age >= c.ttl})
+	}
+	c.mu.Unlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Address != entries[j].Address {
+			return entries[i].Address < entries[j].Address
+		}
+		return entries[i].Edition < entries[j].Edition
+	})
+	return entries
+}
+
+// EvictCache 移除指定地址所有版本的緩存結果，返回移除的條目數
+// 地址與查詢時一樣經過 NormalizeAddress 規範化，地址無效時返回錯誤
+func EvictCache(address string) (int, error) {
+	address, err := NormalizeAddress(address)
+	if err != nil {
+		return 0, err
+	}
+	c := statuses
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := 0
+	for k, e := range c.entries {
+		if e.address == address {
+			delete(c.entries, k)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// FlushCache 清空所有緩存結果，返回移除的條目數
+func FlushCache() int {
+	c := statuses
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := len(c.entries)
+	clear(c.entries)
+	return n
+}
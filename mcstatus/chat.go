@@ -0,0 +1,91 @@
+package mcstatus
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// ChatComponent 表示 Minecraft 的 JSON 文本組件，子組件會繼承父組件的格式
+type ChatComponent struct {
+	Text          string          `json:"text"`
+	Color         string          `json:"color,omitempty"`         // 顏色名稱或十六進制顏色（例如 "#ff5555"）
+	Bold          *bool           `json:"bold,omitempty"`          // 粗體（未設置時繼承父組件）
+	Italic        *bool           `json:"italic,omitempty"`        // 斜體（未設置時繼承父組件）
+	Underlined    *bool           `json:"underlined,omitempty"`    // 底線（未設置時繼承父組件）
+	Strikethrough *bool           `json:"strikethrough,omitempty"` // 刪除線（未設置時繼承父組件）
+	Obfuscated    *bool           `json:"obfuscated,omitempty"`    // 亂碼（未設置時繼承父組件）
+	Extra         []ChatComponent `json:"extra,omitempty"`         // 子組件
+}
+
+// UnmarshalJSON 解析文本組件，支援字符串、數組和對象三種形式
+func (c *ChatComponent) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil
+	}
+
+	switch data[0] {
+	case '"':
+		*c = ChatComponent{}
+		return json.Unmarshal(data, &c.Text)
+	case '[':
+		// 數組形式中，第一個元素為父組件，其餘元素為其子組件
+		var list []ChatComponent
+		if err := json.Unmarshal(data, &list); err != nil {
+			return err
+		}
+		*c = ChatComponent{}
+		if len(list) > 0 {
+			*c = list[0]
+			c.Extra = append(c.Extra, list[1:]...)
+		}
+		return nil
+	default:
+		// 使用不帶 UnmarshalJSON 方法的別名類型，避免無限遞歸
+		type plain ChatComponent
+		return json.Unmarshal(data, (*plain)(c))
+	}
+}
+
+// Flatten 將組件樹展開為文本片段列表，文本中的舊版 § 格式代碼也會被解析
+func (c *ChatComponent) Flatten() []MOTDComponent {
+	return c.flatten(MOTDComponent{}, nil)
+}
+
+// flatten 以 parent 為繼承的格式展開組件，並將結果追加到 out
+func (c *ChatComponent) flatten(parent MOTDComponent, out []MOTDComponent) []MOTDComponent {
+	style := parent
+	style.Text = ""
+	switch c.Color {
+	case "":
+	case "reset":
+		style.Color = ""
+	default:
+		style.Color = c.Color
+	}
+	applyFlag(&style.Bold, c.Bold)
+	applyFlag(&style.Italic, c.Italic)
+	applyFlag(&style.Underlined, c.Underlined)
+	applyFlag(&style.Strikethrough, c.Strikethrough)
+	applyFlag(&style.Obfuscated, c.Obfuscated)
+
+	out = append(out, parseLegacyText(c.Text, style)...)
+	for i := range c.Extra {
+		out = c.Extra[i].flatten(style, out)
+	}
+	return out
+}
+
+// applyFlag 在組件明確設置了格式時覆蓋繼承的值
+func applyFlag(dst *bool, src *bool) {
+	if src != nil {
+		*dst = *src
+	}
+}
+
+// Description 是伺服器的描述（MOTD），除了原始的組件樹外還包含解析後的文本片段
+type Description struct {
+	ChatComponent
+	Components []MOTDComponent `json:"components,omitempty"` // 展開並解析格式後的文本片段
+	HTML       string          `json:"html,omitempty"`       // 渲染後的 HTML（僅在請求時提供）
+}
@@ -0,0 +1,62 @@
+package mcstatus
+
+import (
+	"context"
+	"time"
+)
+
+// Option 設置查詢的可選參數
+type Option func(*QueryOptions)
+
+// WithTimeout 設置整個查詢的時限
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *QueryOptions) { o.Timeout = timeout }
+}
+
+// WithProtocolVersion 設置握手時宣告的客戶端協議版本（例如 765 代表 1.20.4），只適用於 Java 版
+func WithProtocolVersion(protocol int32) Option {
+	return func(o *QueryOptions) { o.ProtocolVersion = protocol }
+}
+
+// WithResolver 使用指定的解析器解析主機名和 SRV 記錄，例如 *net.Resolver 或測試中返回固定記錄的實現
+func WithResolver(r Resolver) Option {
+	return func(o *QueryOptions) { o.Resolver = r }
+}
+
+// WithDialer 使用指定的 dialer 建立出站連接，例如設置了 LocalAddr 的 *net.Dialer、代理，或測試中連接到 net.Pipe 的實現
+func WithDialer(d Dialer) Option {
+	return func(o *QueryOptions) { o.Dialer = d }
+}
+
+// Client 查詢 Minecraft 伺服器狀態，不依賴 HTTP 服務，可在其他 Go 項目中使用
+// 零值可以直接使用；創建時指定的選項作用於所有查詢，單次查詢的選項優先
+type Client struct {
+	opts []Option
+}
+
+// NewClient 創建一個使用指定默認選項的 Client
+func NewClient(opts ...Option) *Client {
+	return &Client{opts: opts}
+}
+
+// StatusContext 查詢 Java 版伺服器的狀態，address 的格式與 NormalizeAddress 相同
+func (c *Client) StatusContext(ctx context.Context, address string, opts ...Option) (*ServerStatus, error) {
+	return GetServerStatusContext(ctx, address, c.options(opts))
+}
+
+// BedrockStatusContext 查詢基岩版伺服器的狀態
+func (c *Client) BedrockStatusContext(ctx context.Context, address string, opts ...Option) (*BedrockStatus, error) {
+	return GetBedrockStatusContext(ctx, address, c.options(opts))
+}
+
+// options 依次套用默認選項和單次查詢的選項
+func (c *Client) options(opts []Option) QueryOptions {
+	var o QueryOptions
+	for _, opt := range c.opts {
+		opt(&o)
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
@@ -0,0 +1,84 @@
+package mcstatus
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+)
+
+// CrossplayStatus 是同時查詢 Java 版和基岩版端口的結果
+type CrossplayStatus struct {
+	Crossplay  bool           `json:"crossplay"` // Java 版和基岩版端口是否都有回應
+	Geyser     bool           `json:"geyser"`    // 基岩版回應是否看起來來自 Geyser
+	Java       *ServerStatus  `json:"java"`      // Java 版狀態（查詢失敗時為 null）
+	Bedrock    *BedrockStatus `json:"bedrock"`   // 基岩版狀態（查詢失敗時為 null）
+	JavaErr    error          `json:"-"`         // Java 版查詢失敗的原因，由調用者轉換為帶錯誤類別的格式
+	BedrockErr error          `json:"-"`         // 基岩版查詢失敗的原因
+}
+
+// GetCrossplayStatus 同時查詢同一主機的 Java 版 TCP 端口和基岩版 UDP 端口，判斷伺服器是否支援跨平台遊玩
+// bedrockPort 為空時使用基岩版默認端口
+func GetCrossplayStatus(address, bedrockPort string, opts QueryOptions) *CrossplayStatus {
+	return GetCrossplayStatusContext(context.Background(), address, bedrockPort, opts)
+}
+
+// GetCrossplayStatusContext 與 GetCrossplayStatus 相同，ctx 被取消時中止兩個查詢
+func GetCrossplayStatusContext(ctx context.Context, address, bedrockPort string, opts QueryOptions) *CrossplayStatus {
+	if bedrockPort == "" {
+		bedrockPort = DefaultBedrockPort
+	}
+	result := &CrossplayStatus{}
+	address, err := NormalizeAddress(address)
+	if err != nil {
+		result.JavaErr, result.BedrockErr = err, err
+		return result
+	}
+	host, _, _ := splitAddress(address, "")
+	bedrockAddress := net.JoinHostPort(host, bedrockPort)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		status, err := GetServerStatusContext(ctx, address, opts)
+		if err != nil {
+			result.JavaErr = err
+			return
+		}
+		result.Java = status
+	}()
+	go func() {
+		defer wg.Done()
+		status, err := GetBedrockStatusContext(ctx, bedrockAddress, opts)
+		if err != nil {
+			result.BedrockErr = err
+			return
+		}
+		result.Bedrock = status
+	}()
+	wg.Wait()
+
+	result.Crossplay = result.Java != nil && result.Bedrock != nil
+	result.Geyser = result.Crossplay && looksLikeGeyser(result.Java, result.Bedrock)
+	return result
+}
+
+// looksLikeGeyser 判斷基岩版回應是否來自 Geyser
+// Geyser 默認將第二行描述設為 "Geyser"；轉發 Java 版狀態時，描述第一行與 Java 版相同，玩家人數也相同
+// 只有玩家人數相同不足以判斷，兩個空伺服器或人數上限相同的獨立伺服器也會如此
+func looksLikeGeyser(java *ServerStatus, bedrock *BedrockStatus) bool {
+	if strings.Contains(strings.ToLower(bedrock.MapName), "geyser") {
+		return true
+	}
+	if java.Players.Online != bedrock.Players.Online || java.Players.Max != bedrock.Players.Max {
+		return false
+	}
+	javaMOTD, _, _ := strings.Cut(RenderPlainText(java.Description.Components), "\n")
+	bedrockMOTD := bedrock.MOTD
+	if len(bedrock.MOTDComponents) > 0 {
+		bedrockMOTD = RenderPlainText(bedrock.MOTDComponents)
+	}
+	javaMOTD, bedrockMOTD = strings.TrimSpace(javaMOTD), strings.TrimSpace(bedrockMOTD)
+	return javaMOTD != "" && javaMOTD == bedrockMOTD
+}
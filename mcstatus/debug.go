@@ -0,0 +1,135 @@
+package mcstatus
+
+import (
+	"encoding/hex"
+	"net"
+	"sync"
+	"time"
+)
+
+// maxTracedBytes 是每個數據包記錄的最大字節數，避免圖標等大型回應使追蹤結果過大
+const maxTracedBytes = 1024
+
+// DebugTrace 記錄查詢過程中收發的數據包和各階段耗時，用於診斷查詢失敗的原因
+// 所有方法都可以在 nil 上調用，此時不記錄任何內容
+type DebugTrace struct {
+	mu         sync.Mutex
+	Phases     []PhaseTiming `json:"phases"`
+	DNS        *DNSTrace     `json:"dns,omitempty"` // DNS 解析成功時的結果
+	Packets    []PacketTrace `json:"packets"`
+	timingOnly bool          // 只記錄各階段耗時，不記錄數據包
+}
+
+// DNSTrace 記錄 DNS 解析的結果及是否來自緩存
+type DNSTrace struct {
+	SRVTarget string   `json:"srv_target,omitempty"` // SRV 記錄指向的主機（沒有使用 SRV 記錄時省略）
+	SRVCached bool     `json:"srv_cached,omitempty"` // SRV 記錄是否來自緩存
+	IPs       []string `json:"ips"`                  // 解析到的 IP
+	Cached    bool     `json:"cached"`               // IP 是否來自緩存
+}
+
+// PhaseTiming 記錄單個查詢階段的耗時
+type PhaseTiming struct {
+	Phase      string  `json:"phase"`           // 階段名稱：dns、dial、handshake、read
+	DurationMs float64 `json:"duration_ms"`     // 耗時（毫秒）
+	Error      string  `json:"error,omitempty"` // 該階段失敗時的錯誤
+}
+
+// PacketTrace 記錄一次收發的數據
+type PacketTrace struct {
+	Direction string `json:"direction"`           // sent 或 received
+	Length    int    `json:"length"`              // 實際長度
+	Truncated bool   `json:"truncated,omitempty"` // 十六進制轉儲是否被截斷
+	Hex       string `json:"hex"`                 // 十六進制轉儲
+}
+
+// NewDebugTrace 創建一個新的 DebugTrace
+func NewDebugTrace() *DebugTrace {
+	return &DebugTrace{
+		Phases:  []PhaseTiming{},
+		Packets: []PacketTrace{},
+	}
+}
+
+// newTimingTrace 創建一個只記錄各階段耗時的 DebugTrace
+func newTimingTrace() *DebugTrace {
+	return &DebugTrace{timingOnly: true}
+}
+
+// phase 記錄一個從 start 開始的階段
+func (t *DebugTrace) phase(name string, start time.Time, err error) {
+	if t == nil {
+		return
+	}
+	p := PhaseTiming{
+		Phase:      name,
+		DurationMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		p.Error = err.Error()
+	}
+
+	t.mu.Lock()
+	t.Phases = append(t.Phases, p)
+	t.mu.Unlock()
+}
+
+// dns 記錄 DNS 解析的結果
+func (t *DebugTrace) dns(srvTarget string, srvCached bool, ips []net.IP, cached bool) {
+	if t == nil {
+		return
+	}
+	d := &DNSTrace{SRVTarget: srvTarget, SRVCached: srvCached, IPs: make([]string, len(ips)), Cached: cached}
+	for i, ip := range ips {
+		d.IPs[i] = ip.String()
+	}
+
+	t.mu.Lock()
+	t.DNS = d
+	t.mu.Unlock()
+}
+
+// packet 記錄一次收發的數據
+func (t *DebugTrace) packet(direction string, data []byte) {
+	if t == nil || t.timingOnly || len(data) == 0 {
+		return
+	}
+	p := PacketTrace{Direction: direction, Length: len(data)}
+	if len(data) > maxTracedBytes {
+		data = data[:maxTracedBytes]
+		p.Truncated = true
+	}
+	p.Hex = hex.Dump(data)
+
+	t.mu.Lock()
+	t.Packets = append(t.Packets, p)
+	t.mu.Unlock()
+}
+
+// wrap 返回一個記錄所有收發數據的連接，t 為 nil 時直接返回原連接
+func (t *DebugTrace) wrap(conn net.Conn) net.Conn {
+	if t == nil || t.timingOnly {
+		return conn
+	}
+	return &tracingConn{Conn: conn, trace: t}
+}
+
+// tracingConn 在讀寫時將數據記錄到 DebugTrace
+type tracingConn struct {
+	net.Conn
+	trace *DebugTrace
+}
+
+// Read 讀取數據並記錄
+func (c *tracingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.trace.packet("received", b[:n])
+	return n, err
+}
+
+// Write 寫入數據並記錄
+func (c *tracingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.trace.packet("sent", b[:n])
+	return n, err
+}
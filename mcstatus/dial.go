@@ -0,0 +1,181 @@
+package mcstatus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/proxy"
+)
+
+// fallbackDelay 是 Happy Eyeballs (RFC 8305) 中啟動下一個連接嘗試前的等待時間
+const fallbackDelay = 250 * time.Millisecond
+
+// Dialer 定義了建立出站連接的方法，net.Dialer 和 SOCKS5 代理都實現了該接口
+// 通過 WithDialer 注入自定義的實現，可以經由其他代理連接、模擬網絡故障，或在測試中連接到內存中的伺服器
+// Java 版查詢使用 "tcp" 網絡，基岩版查詢使用 "udp" 網絡
+type Dialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// newSOCKS5Dialer 創建一個通過 SOCKS5 代理建立連接的 dialer
+// proxyURL 的格式為 "socks5://[user:pass@]host:port"，也可以省略協議部分
+func newSOCKS5Dialer(proxyURL string, forward *net.Dialer) (Dialer, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil || u.Host == "" {
+		u, err = url.Parse("socks5://" + proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("無效的代理地址: %w", err)
+		}
+	}
+	if u.Scheme != "socks5" && u.Scheme != "socks5h" {
+		return nil, fmt.Errorf("不支援的代理協議: %s", u.Scheme)
+	}
+
+	var auth *proxy.Auth
+	if u.User != nil {
+		password, _ := u.User.Password()
+		auth = &proxy.Auth{User: u.User.Username(), Password: password}
+	}
+
+	d, err := proxy.SOCKS5("tcp", u.Host, auth, forward)
+	if err != nil {
+		return nil, fmt.Errorf("創建 SOCKS5 代理失敗: %w", err)
+	}
+	cd, ok := d.(Dialer)
+	if !ok {
+		return nil, errors.New("SOCKS5 代理不支援 context")
+	}
+	return cd, nil
+}
+
+// newOutboundDialer 根據代理和綁定地址設定創建出站連接使用的 dialer
+func newOutboundDialer(proxyURL, bind string) (Dialer, error) {
+	d := &net.Dialer{}
+	if bind != "" {
+		ip, err := resolveBindAddress(bind)
+		if err != nil {
+			return nil, err
+		}
+		d.LocalAddr = &net.TCPAddr{IP: ip}
+	}
+
+	if proxyURL == "" {
+		return d, nil
+	}
+	return newSOCKS5Dialer(proxyURL, d)
+}
+
+// resolveBindAddress 將 IP 地址或網卡名稱解析為綁定使用的本地 IP
+func resolveBindAddress(bind string) (net.IP, error) {
+	if ip := net.ParseIP(bind); ip != nil {
+		return ip, nil
+	}
+
+	iface, err := net.InterfaceByName(bind)
+	if err != nil {
+		return nil, fmt.Errorf("無效的綁定地址 %q: %w", bind, err)
+	}
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return nil, fmt.Errorf("讀取網卡 %s 的地址失敗: %w", bind, err)
+	}
+	for _, addr := range addrs {
+		if ipNet, ok := addr.(*net.IPNet); ok && ipNet.IP.IsGlobalUnicast() {
+			return ipNet.IP, nil
+		}
+	}
+	return nil, fmt.Errorf("網卡 %s 沒有可用的 IP 地址", bind)
+}
+
+// dialResult 記錄單個連接嘗試的結果
+type dialResult struct {
+	conn net.Conn
+	ip   net.IP
+	err  error
+}
+
+// sortAddresses 按照 RFC 8305 的建議交替排列 IPv6 和 IPv4 地址，IPv6 優先
+func sortAddresses(ips []net.IP) []net.IP {
+	var v4, v6 []net.IP
+	for _, ip := range ips {
+		if ip.To4() != nil {
+			v4 = append(v4, ip)
+		} else {
+			v6 = append(v6, ip)
+		}
+	}
+
+	sorted := make([]net.IP, 0, len(ips))
+	for i := 0; i < len(v4) || i < len(v6); i++ {
+		if i < len(v6) {
+			sorted = append(sorted, v6[i])
+		}
+		if i < len(v4) {
+			sorted = append(sorted, v4[i])
+		}
+	}
+	return sorted
+}
+
+// dialAny 依次嘗試連接所有解析到的地址，每次嘗試之間間隔 fallbackDelay，
+// 前一個嘗試失敗時立即開始下一個，返回最先成功建立的連接及其 IP
+func dialAny(ctx context.Context, dialer Dialer, ips []net.IP, port string, timeout time.Duration) (net.Conn, net.IP, error) {
+	if len(ips) == 0 {
+		return nil, nil, errors.New("沒有可用的地址")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	addrs := sortAddresses(ips)
+	results := make(chan dialResult, len(addrs))
+
+	dial := func(ip net.IP) {
+		conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(ip.String(), port))
+		results <- dialResult{conn: conn, ip: ip, err: err}
+	}
+
+	next, pending := 0, 0
+	var firstErr error
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			if next < len(addrs) {
+				go dial(addrs[next])
+				next++
+				pending++
+				timer.Reset(fallbackDelay)
+			}
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				// 取消其他仍在進行的嘗試，並關閉之後才成功的連接
+				cancel()
+				go func(remaining int) {
+					for ; remaining > 0; remaining-- {
+						if r := <-results; r.conn != nil {
+							r.conn.Close()
+						}
+					}
+				}(pending)
+				return res.conn, res.ip, nil
+			}
+			if firstErr == nil {
+				firstErr = res.err
+			}
+			if next < len(addrs) {
+				// 當前嘗試失敗，立即開始下一個
+				timer.Reset(0)
+			} else if pending == 0 {
+				return nil, nil, firstErr
+			}
+		}
+	}
+}
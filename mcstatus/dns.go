@@ -0,0 +1,230 @@
+package mcstatus
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// resolver 定義了解析主機名與 SRV 記錄的方法
+// 無法得知記錄的 TTL 時返回 unknownTTL，由緩存使用默認值；返回 0 表示記錄不應被緩存
+type resolver interface {
+	LookupIP(ctx context.Context, host string) ([]net.IP, time.Duration, error)
+	LookupSRV(ctx context.Context, name string) ([]*net.SRV, time.Duration, error)
+}
+
+// Resolver 定義了查詢使用的 DNS 解析方法，方法簽名與 net.Resolver 相同，因此 *net.Resolver 可以直接使用
+// 通過 WithResolver 注入自定義的實現，可以返回固定的記錄或模擬解析失敗，使測試不依賴真實的 DNS
+type Resolver interface {
+	LookupIP(ctx context.Context, network, host string) ([]net.IP, error)
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+// unknownTTL 表示解析器無法得知記錄的 TTL
+const unknownTTL time.Duration = -1
+
+// systemResolver 將 Resolver 適配為 resolver，r 為 nil 時使用系統的 DNS 解析器
+type systemResolver struct {
+	r Resolver
+}
+
+// LookupIP 解析主機名的 IP 地址
+func (s systemResolver) LookupIP(ctx context.Context, host string) ([]net.IP, time.Duration, error) {
+	ips, err := s.resolver().LookupIP(ctx, "ip", host)
+	return ips, unknownTTL, err
+}
+
+// LookupSRV 查詢指定名稱的 SRV 記錄
+func (s systemResolver) LookupSRV(ctx context.Context, name string) ([]*net.SRV, time.Duration, error) {
+	_, srvs, err := s.resolver().LookupSRV(ctx, "", "", name)
+	return srvs, unknownTTL, err
+}
+
+// resolver 返回實際使用的 Resolver
+func (s systemResolver) resolver() Resolver {
+	if s.r == nil {
+		return net.DefaultResolver
+	}
+	return s.r
+}
+
+// errNoRecords 表示 DNS 查詢沒有返回任何記錄
+var errNoRecords = errors.New("沒有找到任何記錄")
+
+// cachedIPs 緩存的 IP 解析結果
+type cachedIPs struct {
+	ips     []net.IP
+	expires time.Time
+}
+
+// cachedSRV 緩存的 SRV 解析結果，found 為 false 表示該名稱沒有 SRV 記錄
+type cachedSRV struct {
+	target  string
+	port    uint16
+	found   bool
+	expires time.Time
+}
+
+// maxDNSCacheEntries 是 IP 和 SRV 緩存各自的最大條目數，主機名來自用戶輸入，不限制時會無限增長
+const maxDNSCacheEntries = 10000
+
+// dnsCache 在 resolver 之上緩存解析結果，優先使用記錄本身的 TTL
+type dnsCache struct {
+	resolver   resolver
+	defaultTTL time.Duration
+
+	mu        sync.Mutex
+	ips       map[string]cachedIPs
+	srvs      map[string]cachedSRV
+	lastPrune time.Time
+}
+
+// newDNSCache 創建一個新的 dnsCache，defaultTTL <= 0 時不緩存
+func newDNSCache(r resolver, defaultTTL time.Duration) *dnsCache {
+	return &dnsCache{
+		resolver:   r,
+		defaultTTL: defaultTTL,
+		ips:        make(map[string]cachedIPs),
+		srvs:       make(map[string]cachedSRV),
+	}
+}
+
+// ttl 決定緩存的有效期
+func (c *dnsCache) ttl(recordTTL time.Duration) time.Duration {
+	if c.defaultTTL <= 0 {
+		return 0
+	}
+	if recordTTL < 0 {
+		return c.defaultTTL
+	}
+	// 記錄的 TTL 為 0 時不緩存
+	return recordTTL
+}
+
+// lookupIP 解析主機名的 IP 地址，返回結果以及是否來自緩存
+func (c *dnsCache) lookupIP(ctx context.Context, host string) ([]net.IP, bool, error) {
+	if ip := net.ParseIP(host); ip != nil {
+		return []net.IP{ip}, false, nil
+	}
+
+	key := strings.ToLower(host)
+	now := time.Now()
+
+	c.mu.Lock()
+	entry, ok := c.ips[key]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.ips, true, nil
+	}
+
+	ips, recordTTL, err := c.resolver.LookupIP(ctx, host)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(ips) == 0 {
+		return nil, false, errNoRecords
+	}
+
+	if ttl := c.ttl(recordTTL); ttl > 0 {
+		c.mu.Lock()
+		c.prune(now)
+		c.ips[key] = cachedIPs{ips: ips, expires: now.Add(ttl)}
+		c.mu.Unlock()
+	}
+	return ips, false, nil
+}
+
+// lookupMinecraftSRV 查詢主機的 _minecraft._tcp SRV 記錄
+// 返回目標主機、端口、是否找到記錄以及是否來自緩存
+func (c *dnsCache) lookupMinecraftSRV(ctx context.Context, host string) (string, uint16, bool, bool) {
+	if net.ParseIP(host) != nil {
+		return "", 0, false, false
+	}
+
+	key := strings.ToLower(host)
+	now := time.Now()
+
+	c.mu.Lock()
+	entry, ok := c.srvs[key]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.target, entry.port, entry.found, true
+	}
+
+	srvs, recordTTL, err := c.resolver.LookupSRV(ctx, "_minecraft._tcp."+host)
+	result := cachedSRV{}
+	if err == nil && len(srvs) > 0 {
+		result.target = strings.TrimSuffix(srvs[0].Target, ".")
+		result.port = srvs[0].Port
+		result.found = true
+	} else if err != nil && !isNotFound(err) {
+		// 暫時性錯誤不緩存，下次請求時重試
+		return "", 0, false, false
+	} else {
+		// 沒有記錄時無法得知 TTL，使用默認值緩存
+		recordTTL = unknownTTL
+	}
+
+	if ttl := c.ttl(recordTTL); ttl > 0 {
+		result.expires = now.Add(ttl)
+		c.mu.Lock()
+		c.prune(now)
+		c.srvs[key] = result
+		c.mu.Unlock()
+	}
+	return result.target, result.port, result.found, false
+}
+
+// prune 在寫入緩存前移除過期的條目，調用者需持有 c.mu
+// 每隔 defaultTTL 清理一次過期條目；緩存已滿時隨機移除條目以騰出空間
+func (c *dnsCache) prune(now time.Time) {
+	if now.Sub(c.lastPrune) >= c.defaultTTL {
+		c.lastPrune = now
+		for k, e := range c.ips {
+			if !now.Before(e.expires) {
+				delete(c.ips, k)
+			}
+		}
+		for k, e := range c.srvs {
+			if !now.Before(e.expires) {
+				delete(c.srvs, k)
+			}
+		}
+	}
+	for k := range c.ips {
+		if len(c.ips) < maxDNSCacheEntries {
+			break
+		}
+		delete(c.ips, k)
+	}
+	for k := range c.srvs {
+		if len(c.srvs) < maxDNSCacheEntries {
+			break
+		}
+		delete(c.srvs, k)
+	}
+}
+
+// isNotFound 判斷錯誤是否表示記錄不存在
+func isNotFound(err error) bool {
+	if errors.Is(err, errNoRecords) {
+		return true
+	}
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+// LookupReverseDNS 查詢 IP 的 PTR 記錄，返回第一個名稱（不帶結尾的點），查無記錄或失敗時返回空字符串
+func LookupReverseDNS(ctx context.Context, ip string) string {
+	if net.ParseIP(ip) == nil {
+		return ""
+	}
+	names, err := net.DefaultResolver.LookupAddr(ctx, ip)
+	if err != nil || len(names) == 0 {
+		return ""
+	}
+	return strings.TrimSuffix(names[0], ".")
+}
@@ -0,0 +1,95 @@
+package mcstatus
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// serveDoH 啟動一個 DoH 測試伺服器，A 記錄查詢返回一組帶有指定 TTL 的記錄，返回 A 記錄的查詢次數
+func serveDoH(t *testing.T, ttls []uint32) (*dohResolver, *atomic.Int32) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req dnsmessage.Message
+		if err := req.Unpack(body); err != nil || len(req.Questions) != 1 {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		q := req.Questions[0]
+		reply := dnsmessage.Message{
+			Header:    dnsmessage.Header{Response: true, RecursionAvailable: true},
+			Questions: req.Questions,
+		}
+		if q.Type == dnsmessage.TypeA {
+			hits.Add(1)
+			for i, ttl := range ttls {
+				reply.Answers = append(reply.Answers, dnsmessage.Resource{
+					Header: dnsmessage.ResourceHeader{Name: q.Name, Type: q.Type, Class: q.Class, TTL: ttl},
+					Body:   &dnsmessage.AResource{A: [4]byte{192, 0, 2, byte(i + 1)}},
+				})
+			}
+		}
+		packed, _ := reply.Pack()
+		w.Header().Set("Content-Type", "application/dns-message")
+		w.Write(packed)
+	}))
+	t.Cleanup(srv.Close)
+	return newDoHResolver(srv.URL), &hits
+}
+
+func TestDoHLookupIPMinTTL(t *testing.T) {
+	tests := []struct {
+		name string
+		ttls []uint32
+		want time.Duration
+	}{
+		{name: "取最小值", ttls: []uint32{300, 60, 120}, want: 60 * time.Second},
+		{name: "TTL 為 0 的記錄在前", ttls: []uint32{0, 300}, want: 0},
+		{name: "TTL 為 0 的記錄在後", ttls: []uint32{300, 0}, want: 0},
+		{name: "全部為 0", ttls: []uint32{0, 0}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, _ := serveDoH(t, tt.ttls)
+			ips, ttl, err := r.LookupIP(context.Background(), "example.com")
+			if err != nil || len(ips) != len(tt.ttls) {
+				t.Fatalf("LookupIP 返回 %v, %v", ips, err)
+			}
+			if ttl != tt.want {
+				t.Errorf("TTL 為 %v，應為 %v", ttl, tt.want)
+			}
+		})
+	}
+}
+
+func TestDNSCacheZeroTTL(t *testing.T) {
+	tests := []struct {
+		name string
+		ttls []uint32
+		hits int32
+	}{
+		{name: "TTL 為 0 時不緩存", ttls: []uint32{0, 300}, hits: 2},
+		{name: "TTL 大於 0 時緩存", ttls: []uint32{300}, hits: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, hits := serveDoH(t, tt.ttls)
+			c := newDNSCache(r, time.Minute)
+			for i := 0; i < 2; i++ {
+				if _, _, err := c.lookupIP(context.Background(), "example.com"); err != nil {
+					t.Fatalf("lookupIP 失敗: %v", err)
+				}
+			}
+			if n := hits.Load(); n != tt.hits {
+				t.Errorf("解析了 %d 次，應為 %d 次", n, tt.hits)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package mcstatus
+
+import (
+	"context"
+	"log"
+)
+
+// 伺服器版本
+const (
+	EditionJava    = "java"
+	EditionBedrock = "bedrock"
+)
+
+// EditionStatus 是自動檢測版本時的查詢結果，Java 和 Bedrock 中只有一個不為 nil
+type EditionStatus struct {
+	Edition string
+	Java    *ServerStatus
+	Bedrock *BedrockStatus
+}
+
+// GetStatusAutoEdition 依次嘗試 Java 版和基岩版查詢，返回最先成功的結果
+// 地址使用基岩版默認端口（19132/19133）時優先嘗試基岩版，否則優先嘗試 Java 版
+func GetStatusAutoEdition(address string, opts QueryOptions) (*EditionStatus, error) {
+	return GetStatusAutoEditionContext(context.Background(), address, opts)
+}
+
+// GetStatusAutoEditionContext 與 GetStatusAutoEdition 相同，ctx 被取消時中止查詢，並且不再嘗試下一個版本
+func GetStatusAutoEditionContext(ctx context.Context, address string, opts QueryOptions) (*EditionStatus, error) {
+	address, err := NormalizeAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	order := []string{EditionJava, EditionBedrock}
+	if _, port, hasPort := splitAddress(address, ""); hasPort && (port == "19132" || port == "19133") {
+		order = []string{EditionBedrock, EditionJava}
+	}
+	if bedrockDisabled.Load() {
+		order = []string{EditionJava}
+	}
+
+	var errs []error
+	for _, edition := range order {
+		switch edition {
+		case EditionJava:
+			status, err := GetServerStatusContext(ctx, address, opts)
+			if err == nil {
+				return &EditionStatus{Edition: EditionJava, Java: status}, nil
+			}
+			errs = append(errs, err)
+		case EditionBedrock:
+			status, err := GetBedrockStatusContext(ctx, address, opts)
+			if err == nil {
+				return &EditionStatus{Edition: EditionBedrock, Bedrock: status}, nil
+			}
+			errs = append(errs, err)
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		log.Printf("%s 版查詢失敗，嘗試下一個版本", edition)
+	}
+
+	if len(errs) == 1 {
+		return nil, errs[0]
+	}
+	return nil, newError(ErrorCodeOf(errs[0]), "Java 版和基岩版查詢均失敗", MultiError(errs))
+}
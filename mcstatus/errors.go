@@ -0,0 +1,119 @@
+package mcstatus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"syscall"
+)
+
+// ErrorCode 是查詢錯誤的類別，值保持穩定以便前端根據類別顯示不同的狀態
+type ErrorCode string
+
+// 查詢錯誤的類別
+const (
+	CodeInvalidAddress     ErrorCode = "INVALID_ADDRESS"      // 地址或端口格式錯誤
+	CodeInvalidBindAddress ErrorCode = "INVALID_BIND_ADDRESS" // 出站綁定地址無效
+	CodeDNSFailure         ErrorCode = "DNS_FAILURE"          // 無法解析主機名
+	CodeConnectTimeout     ErrorCode = "CONNECT_TIMEOUT"      // 建立連接超時
+	CodeConnectionRefused  ErrorCode = "CONNECTION_REFUSED"   // 連接被拒絕（伺服器離線）
+	CodeConnectionFailed   ErrorCode = "CONNECTION_FAILED"    // 其他連接錯誤
+	CodeReadTimeout        ErrorCode = "READ_TIMEOUT"         // 等待伺服器回應超時
+	CodeInvalidPacket      ErrorCode = "INVALID_PACKET"       // 伺服器回應的數據包不符合協議
+	CodeParseError         ErrorCode = "PARSE_ERROR"          // 無法解析伺服器回應的內容
+	CodeNoFavicon          ErrorCode = "NO_FAVICON"           // 伺服器沒有設置圖標
+	CodeInvalidFavicon     ErrorCode = "INVALID_FAVICON"      // 伺服器圖標無法解碼
+	CodePlayerNotFound     ErrorCode = "PLAYER_NOT_FOUND"     // 玩家不存在或沒有自定義皮膚
+	CodeProfileUnavailable ErrorCode = "PROFILE_UNAVAILABLE"  // 無法從 Mojang 獲取玩家資料
+	CodeBedrockDisabled    ErrorCode = "BEDROCK_DISABLED"     // 基岩版查詢已停用
+	CodeHostBusy           ErrorCode = "HOST_BUSY"            // 等待對該伺服器的連接名額的請求過多或超時
+)
+
+// Error 是帶有錯誤類別的查詢錯誤
+type Error struct {
+	Code    ErrorCode
+	Message string
+	Err     error
+}
+
+// Error 返回錯誤信息
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Err)
+	}
+	return e.Message
+}
+
+// Unwrap 返回底層錯誤
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// MultiError 是多個查詢錯誤的集合，錯誤信息以分號連接
+type MultiError []error
+
+// Error 返回錯誤信息
+func (m MultiError) Error() string {
+	msgs := make([]string, len(m))
+	for i, err := range m {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap 返回所有底層錯誤
+func (m MultiError) Unwrap() []error {
+	return m
+}
+
+// newError 創建一個帶有錯誤類別的查詢錯誤
+func newError(code ErrorCode, message string, err error) *Error {
+	return &Error{Code: code, Message: message, Err: err}
+}
+
+// ErrorCodeOf 返回錯誤的類別，未分類的錯誤返回空字符串
+func ErrorCodeOf(err error) ErrorCode {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return ""
+}
+
+// classifyDialError 根據連接錯誤的原因返回對應的錯誤類別
+func classifyDialError(err error) ErrorCode {
+	switch {
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return CodeConnectionRefused
+	case isTimeout(err):
+		return CodeConnectTimeout
+	default:
+		return CodeConnectionFailed
+	}
+}
+
+// classifyReadError 根據讀取錯誤的原因返回對應的錯誤類別
+func classifyReadError(err error) ErrorCode {
+	switch {
+	case isTimeout(err):
+		return CodeReadTimeout
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return CodeConnectionRefused
+	case errors.Is(err, syscall.ECONNRESET):
+		return CodeConnectionFailed
+	default:
+		return CodeInvalidPacket
+	}
+}
+
+// isTimeout 判斷錯誤是否由超時引起
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, os.ErrDeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,122 @@
+package mcstatus
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"strings"
+
+	"github.com/HugoSmits86/nativewebp"
+	"golang.org/x/image/draw"
+)
+
+// faviconPrefix 是伺服器圖標 data URL 的前綴
+const faviconPrefix = "data:image/png;base64,"
+
+// ErrNoFavicon 表示伺服器沒有設置圖標
+var ErrNoFavicon = newError(CodeNoFavicon, "伺服器沒有設置圖標", nil)
+
+// DecodeFavicon 將伺服器回應中的 data URL 圖標解碼為 PNG 數據
+func DecodeFavicon(favicon string) ([]byte, error) {
+	if favicon == "" {
+		return nil, ErrNoFavicon
+	}
+
+	// 部分伺服器會在 base64 數據中插入換行
+	data := strings.TrimPrefix(favicon, faviconPrefix)
+	data = strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\r' {
+			return -1
+		}
+		return r
+	}, data)
+
+	png, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, newError(CodeInvalidFavicon, "解碼伺服器圖標失敗", err)
+	}
+	return png, nil
+}
+
+// faviconSize 是協議規定的伺服器圖標邊長
+const faviconSize = 64
+
+// ValidateFavicon 檢查圖標是否為合法的 64×64 PNG，並返回圖標內容的 SHA-256 哈希值
+// 哈希值基於解碼後的 PNG 數據計算，不受 base64 中的換行等差異影響
+func ValidateFavicon(favicon string) (string, error) {
+	data, err := DecodeFavicon(favicon)
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(favicon, faviconPrefix) {
+		return "", errors.New("伺服器圖標不是 PNG 格式的 data URL")
+	}
+
+	cfg, err := png.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return "", fmt.Errorf("伺服器圖標不是有效的 PNG: %w", err)
+	}
+	if cfg.Width != faviconSize || cfg.Height != faviconSize {
+		return "", fmt.Errorf("伺服器圖標尺寸應為 %d×%d，實際為 %d×%d", faviconSize, faviconSize, cfg.Width, cfg.Height)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// decodeFaviconImage 解碼伺服器圖標
+// 圖標來自遠程伺服器，先讀取標頭確認尺寸為 64×64，避免聲明巨大尺寸的小文件在解碼時耗盡內存
+func decodeFaviconImage(data []byte) (image.Image, error) {
+	cfg, err := png.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Width != faviconSize || cfg.Height != faviconSize {
+		return nil, fmt.Errorf("伺服器圖標尺寸應為 %d×%d，實際為 %d×%d", faviconSize, faviconSize, cfg.Width, cfg.Height)
+	}
+	return png.Decode(bytes.NewReader(data))
+}
+
+// ConvertFavicon 將 PNG 圖標縮放到 size×size 並編碼為指定格式（"png" 或 "webp"）
+// size 為 0 時保持原尺寸，返回編碼後的數據及其 Content-Type
+func ConvertFavicon(data []byte, size int, format string) ([]byte, string, error) {
+	if size == 0 && format == "png" {
+		return data, "image/png", nil
+	}
+
+	src, err := decodeFaviconImage(data)
+	if err != nil {
+		return nil, "", newError(CodeInvalidFavicon, "解析伺服器圖標失敗", err)
+	}
+
+	img := src
+	if size > 0 && size != src.Bounds().Dx() {
+		dst := image.NewNRGBA(image.Rect(0, 0, size, size))
+		// 放大時使用最近鄰插值以保持像素風格，縮小時使用 Catmull-Rom 插值以減少鋸齒
+		var scaler draw.Scaler = draw.CatmullRom
+		if size > src.Bounds().Dx() {
+			scaler = draw.NearestNeighbor
+		}
+		scaler.Scale(dst, dst.Bounds(), src, src.Bounds(), draw.Src, nil)
+		img = dst
+	}
+
+	var buf bytes.Buffer
+	switch format {
+	case "png":
+		err = png.Encode(&buf, img)
+	case "webp":
+		err = nativewebp.Encode(&buf, img, nil)
+	default:
+		return nil, "", fmt.Errorf("不支援的圖片格式: %s", format)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("編碼伺服器圖標失敗: %w", err)
+	}
+	return buf.Bytes(), "image/" + format, nil
+}
@@ -0,0 +1,216 @@
+package mcstatus
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"unicode/utf16"
+)
+
+// ForgeInfo 是 Forge（或 NeoForge）伺服器在狀態回應中附帶的模組信息
+type ForgeInfo struct {
+	Type              string     `json:"type"`                // 數據格式：FML（1.7–1.12）、FML2（1.13–1.17）或 FML3（1.18+）
+	FMLNetworkVersion int        `json:"fml_network_version"` // FML 網絡協議版本
+	Mods              []ForgeMod `json:"mods"`                // 模組列表
+	Truncated         bool       `json:"truncated,omitempty"` // 伺服器是否截斷了模組列表
+}
+
+// ForgeMod 是單個模組的信息
+type ForgeMod struct {
+	ID         string `json:"id"`
+	Version    string `json:"version,omitempty"`
+	ServerOnly bool   `json:"server_only,omitempty"` // 僅伺服器端需要的模組，不會附帶版本
+}
+
+// forgeStatusFields 是狀態回應中與 Forge 相關的字段
+type forgeStatusFields struct {
+	// 1.7–1.12 使用的舊格式
+	ModInfo *struct {
+		Type    string `json:"type"`
+		ModList []struct {
+			ModID   string `json:"modid"`
+			Version string `json:"version"`
+		} `json:"modList"`
+	} `json:"modinfo"`
+
+	// 1.13 之後使用的格式
+	ForgeData *struct {
+		FMLNetworkVersion int  `json:"fmlNetworkVersion"`
+		Truncated         bool `json:"truncated"`
+		Mods              []struct {
+			ModID     string `json:"modId"`
+			ModMarker string `json:"modmarker"`
+		} `json:"mods"`
+		// 1.18 之後模組列表經過壓縮編碼後放在 d 字段中
+		D string `json:"d"`
+	} `json:"forgeData"`
+}
+
+// parseForgeInfo 從原始狀態回應中解析 Forge 模組信息，非 Forge 伺服器返回 nil
+func parseForgeInfo(raw []byte) *ForgeInfo {
+	var fields forgeStatusFields
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil
+	}
+
+	switch {
+	case fields.ForgeData != nil:
+		data := fields.ForgeData
+		info := &ForgeInfo{
+			Type:              "FML2",
+			FMLNetworkVersion: data.FMLNetworkVersion,
+			Truncated:         data.Truncated,
+			Mods:              []ForgeMod{},
+		}
+		for _, mod := range data.Mods {
+			info.Mods = append(info.Mods, ForgeMod{ID: mod.ModID, Version: mod.ModMarker})
+		}
+		if data.D != "" {
+			info.Type = "FML3"
+			mods, truncated, err := decodeForgeOptimized(data.D)
+			if err != nil {
+				log.Printf("解析 Forge 模組列表失敗: %v", err)
+			} else {
+				info.Mods = mods
+				info.Truncated = truncated
+			}
+		}
+		return info
+
+	case fields.ModInfo != nil:
+		info := &ForgeInfo{
+			Type:              "FML",
+			FMLNetworkVersion: 1,
+			Mods:              []ForgeMod{},
+		}
+		for _, mod := range fields.ModInfo.ModList {
+			info.Mods = append(info.Mods, ForgeMod{ID: mod.ModID, Version: mod.Version})
+		}
+		return info
+	}
+
+	return nil
+}
+
+// decodeForgeOptimized 解碼 FML3 格式中 d 字段的模組列表
+// 該字段將二進制數據以每個 UTF-16 字符 15 位的方式打包，前兩個字符為數據長度
+func decodeForgeOptimized(d string) ([]ForgeMod, bool, error) {
+	units := utf16.Encode([]rune(d))
+	if len(units) < 2 {
+		return nil, false, errors.New("數據過短")
+	}
+	// 長度由伺服器控制，必須在分配內存之前確認剩餘的字符足以容納該長度
+	size := int(units[0]&0x7FFF) | int(units[1]&0x7FFF)<<15
+	if available := (len(units) - 2) * 15 / 8; size > available {
+		return nil, false, fmt.Errorf("數據長度不足：需要 %d 字節，實際 %d 字節", size, available)
+	}
+
+	data := make([]byte, 0, size)
+	var buffer uint32
+	bits := 0
+	for _, u := range units[2:] {
+		buffer |= uint32(u&0x7FFF) << bits
+		bits += 15
+		for bits >= 8 && len(data) < size {
+			data = append(data, byte(buffer))
+			buffer >>= 8
+			bits -= 8
+		}
+	}
+	if len(data) < size {
+		return nil, false, fmt.Errorf("數據長度不足：需要 %d 字節，實際 %d 字節", size, len(data))
+	}
+
+	r := &forgeReader{data: data}
+	truncated := r.readBool()
+	modCount := int(r.readUint16())
+
+	mods := make([]ForgeMod, 0, min(modCount, len(data)))
+	for i := 0; i < modCount && r.err == nil; i++ {
+		flags := r.readVarInt()
+		channelCount := int(flags >> 1)
+		serverOnly := flags&1 != 0
+
+		mod := ForgeMod{ID: r.readString(), ServerOnly: serverOnly}
+		if !serverOnly {
+			mod.Version = r.readString()
+		}
+		// 跳過模組的網絡頻道信息：名稱、版本、客戶端是否必需
+		for j := 0; j < channelCount && r.err == nil; j++ {
+			r.readString()
+			r.readString()
+			r.readBool()
+		}
+		mods = append(mods, mod)
+	}
+	if r.err != nil {
+		return nil, false, r.err
+	}
+	return mods, truncated, nil
+}
+
+// forgeReader 用於讀取 Forge 的二進制數據，遇到錯誤後的讀取都返回零值
+type forgeReader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+// read 讀取 n 個字節
+func (r *forgeReader) read(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if r.pos+n > len(r.data) {
+		r.err = io.ErrUnexpectedEOF
+		return nil
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b
+}
+
+// readBool 讀取一個布爾值
+func (r *forgeReader) readBool() bool {
+	b := r.read(1)
+	return b != nil && b[0] != 0
+}
+
+// readUint16 讀取一個大端序的無符號短整數
+func (r *forgeReader) readUint16() uint16 {
+	b := r.read(2)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint16(b)
+}
+
+// readVarInt 讀取一個 VarInt
+func (r *forgeReader) readVarInt() int32 {
+	var result uint32
+	for i := 0; i < 5; i++ {
+		b := r.read(1)
+		if b == nil {
+			return 0
+		}
+		result |= uint32(b[0]&0x7F) << (7 * i)
+		if b[0]&0x80 == 0 {
+			return int32(result)
+		}
+	}
+	r.err = errors.New("VarInt 過長")
+	return 0
+}
+
+// readString 讀取一個以 VarInt 長度為前綴的 UTF-8 字符串
+func (r *forgeReader) readString() string {
+	n := r.readVarInt()
+	if n < 0 {
+		r.err = errors.New("無效的字符串長度")
+		return ""
+	}
+	return string(r.read(int(n)))
+}
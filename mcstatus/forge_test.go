@@ -0,0 +1,113 @@
+package mcstatus
+
+import (
+	"encoding/binary"
+	"reflect"
+	"runtime"
+	"testing"
+	"unicode/utf16"
+)
+
+// packForgeData 按 FML3 的格式將 data 打包為 d 字段，size 為寫入頭部的數據長度，可以與 data 的實際長度不同
+func packForgeData(data []byte, size int) string {
+	units := []uint16{uint16(size & 0x7FFF), uint16(size >> 15 & 0x7FFF)}
+	var buffer uint32
+	bits := 0
+	for _, b := range data {
+		buffer |= uint32(b) << bits
+		bits += 8
+		for bits >= 15 {
+			units = append(units, uint16(buffer&0x7FFF))
+			buffer >>= 15
+			bits -= 15
+		}
+	}
+	if bits > 0 {
+		units = append(units, uint16(buffer&0x7FFF))
+	}
+	return string(utf16.Decode(units))
+}
+
+// forgeModList 按 FML3 的格式編碼模組列表，每個模組帶有一個網絡頻道
+func forgeModList(truncated bool, mods []ForgeMod) []byte {
+	appendString := func(buf []byte, s string) []byte {
+		return append(AppendVarInt(buf, int32(len(s))), s...)
+	}
+	data := []byte{0}
+	if truncated {
+		data[0] = 1
+	}
+	data = binary.BigEndian.AppendUint16(data, uint16(len(mods)))
+	for _, mod := range mods {
+		flags := int32(1 << 1)
+		if mod.ServerOnly {
+			flags |= 1
+		}
+		data = AppendVarInt(data, flags)
+		data = appendString(data, mod.ID)
+		if !mod.ServerOnly {
+			data = appendString(data, mod.Version)
+		}
+		data = appendString(data, mod.ID+":main")
+		data = appendString(data, "1")
+		data = append(data, 1)
+	}
+	return data
+}
+
+func TestDecodeForgeOptimized(t *testing.T) {
+	mods := []ForgeMod{
+		{ID: "forge", Version: "49.0.3"},
+		{ID: "jei", Version: "17.3.0.49"},
+		{ID: "spark", ServerOnly: true},
+	}
+	list := forgeModList(true, mods)
+
+	tests := []struct {
+		name          string
+		d             string
+		wantMods      []ForgeMod
+		wantTruncated bool
+		wantErr       bool
+	}{
+		{name: "模組列表", d: packForgeData(list, len(list)), wantMods: mods, wantTruncated: true},
+		{name: "空列表", d: packForgeData(forgeModList(false, nil), 3), wantMods: []ForgeMod{}},
+		{name: "空字符串", d: "", wantErr: true},
+		{name: "只有長度", d: packForgeData(nil, 0)[:1], wantErr: true},
+		{name: "長度超出剩餘數據", d: packForgeData(list, len(list)+16), wantErr: true},
+		{name: "最大的長度", d: packForgeData(list, 1<<30-1), wantErr: true},
+		{name: "模組數量超出數據", d: packForgeData([]byte{0, 0xff, 0xff}, 3), wantErr: true},
+		{name: "模組數據不完整", d: packForgeData(list[:len(list)-4], len(list)-4), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, truncated, err := decodeForgeOptimized(tt.d)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("decodeForgeOptimized 應返回錯誤，實際返回 %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("decodeForgeOptimized 返回錯誤: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.wantMods) || truncated != tt.wantTruncated {
+				t.Errorf("decodeForgeOptimized = %v, %v，應為 %v, %v", got, truncated, tt.wantMods, tt.wantTruncated)
+			}
+		})
+	}
+}
+
+// 頭部的長度由伺服器控制，即使聲稱的長度接近 1 GiB 也不應按該長度分配內存
+func TestDecodeForgeOptimizedOversizedAllocation(t *testing.T) {
+	d := packForgeData([]byte{0, 0, 0}, 1<<30-1)
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	if _, _, err := decodeForgeOptimized(d); err == nil {
+		t.Fatal("decodeForgeOptimized 應返回錯誤")
+	}
+	runtime.ReadMemStats(&after)
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 1<<20 {
+		t.Errorf("decodeForgeOptimized 分配了 %d 字節", allocated)
+	}
+}
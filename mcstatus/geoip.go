@@ -0,0 +1,95 @@
+package mcstatus
+
+import (
+	"log"
+	"net"
+
+	"github.com/oschwald/geoip2-golang"
+)
+
+// geoDB 是 MaxMind GeoLite2 City 數據庫，為 nil 時不查詢地理位置
+var geoDB *geoip2.Reader
+
+// asnDB 是 MaxMind GeoLite2 ASN 數據庫，為 nil 時不查詢 ASN
+var asnDB *geoip2.Reader
+
+// GeoLocation 是伺服器 IP 的地理位置
+type GeoLocation struct {
+	Country     string  `json:"country,omitempty"`      // 國家名稱（英文）
+	CountryCode string  `json:"country_code,omitempty"` // ISO 3166-1 國家代碼
+	City        string  `json:"city,omitempty"`         // 城市名稱（英文）
+	Latitude    float64 `json:"latitude"`               // 緯度
+	Longitude   float64 `json:"longitude"`              // 經度
+}
+
+// NetworkInfo 是伺服器 IP 所屬的自治系統，可用於識別託管商（例如 OVH、Hetzner）
+type NetworkInfo struct {
+	ASN          uint   `json:"asn"`          // 自治系統編號
+	Organization string `json:"organization"` // 自治系統所屬的組織
+}
+
+// SetGeoIPDatabase 載入 MaxMind GeoLite2 City（或 GeoIP2 City）數據庫，之後的查詢結果會附帶伺服器的地理位置
+func SetGeoIPDatabase(path string) error {
+	db, err := geoip2.Open(path)
+	if err != nil {
+		return err
+	}
+	geoDB = db
+	return nil
+}
+
+// lookupLocation 查詢 IP 的地理位置，未載入數據庫或查無記錄時返回 nil
+func lookupLocation(ip string) *GeoLocation {
+	if geoDB == nil {
+		return nil
+	}
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return nil
+	}
+	record, err := geoDB.City(parsed)
+	if err != nil {
+		log.Printf("查詢 IP %s 的地理位置失敗: %v", ip, err)
+		return nil
+	}
+	if record.Country.IsoCode == "" && record.Location.Latitude == 0 && record.Location.Longitude == 0 {
+		return nil
+	}
+	return &GeoLocation{
+		Country:     record.Country.Names["en"],
+		CountryCode: record.Country.IsoCode,
+		City:        record.City.Names["en"],
+		Latitude:    record.Location.Latitude,
+		Longitude:   record.Location.Longitude,
+	}
+}
+
+// SetASNDatabase 載入 MaxMind GeoLite2 ASN 數據庫，用於查詢伺服器 IP 所屬的自治系統
+func SetASNDatabase(path string) error {
+	db, err := geoip2.Open(path)
+	if err != nil {
+		return err
+	}
+	asnDB = db
+	return nil
+}
+
+// LookupNetwork 查詢 IP 所屬的自治系統，未載入數據庫或查無記錄時返回 nil
+func LookupNetwork(ip string) *NetworkInfo {
+	if asnDB == nil {
+		return nil
+	}
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return nil
+	}
+	record, err := asnDB.ASN(parsed)
+	if err != nil {
+		log.Printf("查詢 IP %s 的 ASN 失敗: %v", ip, err)
+		return nil
+	}
+	if record.AutonomousSystemNumber == 0 {
+		return nil
+	}
+	return &NetworkInfo{ASN: record.AutonomousSystemNumber, Organization: record.AutonomousSystemOrganization}
+}
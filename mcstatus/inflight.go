@@ -0,0 +1,54 @@
+package mcstatus
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// InflightQuery 是一個正在進行的出站查詢
+type InflightQuery struct {
+	Edition string    // 查詢的版本
+	Target  string    // 查詢的地址
+	Client  string    // 發起查詢的客戶端，後台查詢為空
+	Started time.Time // 開始查詢的時間
+}
+
+// inflightQueries 記錄正在進行的出站查詢
+type inflightQueries struct {
+	mu      sync.Mutex
+	next    uint64
+	queries map[uint64]InflightQuery
+}
+
+var inflight = &inflightQueries{queries: make(map[uint64]InflightQuery)}
+
+// track 記錄一個開始的查詢，返回查詢結束時調用的函數
+func (q *inflightQueries) track(edition, target, client string) func() {
+	q.mu.Lock()
+	id := q.next
+	q.next++
+	q.queries[id] = InflightQuery{Edition: edition, Target: target, Client: client, Started: time.Now()}
+	q.mu.Unlock()
+
+	return func() {
+		q.mu.Lock()
+		delete(q.queries, id)
+		q.mu.Unlock()
+	}
+}
+
+// InflightQueries 返回所有正在進行的出站查詢，按開始時間排序
+func InflightQueries() []InflightQuery {
+	inflight.mu.Lock()
+	queries := make([]InflightQuery, 0, len(inflight.queries))
+	for _, q := range inflight.queries {
+		queries = append(queries, q)
+	}
+	inflight.mu.Unlock()
+
+	sort.Slice(queries, func(i, j int) bool {
+		return queries[i].Started.Before(queries[j].Started)
+	})
+	return queries
+}
@@ -0,0 +1,82 @@
+package mcstatus
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// 排隊等待連接名額的限制，目標緩慢或無回應時避免請求無限堆積
+const (
+	maxQueuedPerHost = 32               // 每個目標最多排隊等待的請求數，超出時立即失敗
+	maxQueueWait     = 10 * time.Second // 等待名額的最長時間
+)
+
+// hostLimiter 限制對同一目標伺服器的同時連接數，超出限制的請求會排隊等待
+type hostLimiter struct {
+	mu    sync.Mutex
+	limit int
+	slots map[string]*hostSlot
+}
+
+// hostSlot 記錄單個目標的信號量及引用計數
+type hostSlot struct {
+	sem  chan struct{}
+	refs int
+}
+
+// newHostLimiter 創建一個新的 hostLimiter，limit <= 0 時返回 nil 表示不限制
+func newHostLimiter(limit int) *hostLimiter {
+	if limit <= 0 {
+		return nil
+	}
+	return &hostLimiter{
+		limit: limit,
+		slots: make(map[string]*hostSlot),
+	}
+}
+
+// acquire 獲取指定目標的連接名額，必要時阻塞等待，返回釋放名額的函數
+// 排隊的請求過多、等待超過 maxQueueWait 或 ctx 結束時返回 CodeHostBusy 錯誤
+func (l *hostLimiter) acquire(ctx context.Context, key string) (release func(), err error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	slot, ok := l.slots[key]
+	if !ok {
+		slot = &hostSlot{sem: make(chan struct{}, l.limit)}
+		l.slots[key] = slot
+	}
+	if slot.refs >= l.limit+maxQueuedPerHost {
+		l.mu.Unlock()
+		return nil, newError(CodeHostBusy, "對該伺服器的查詢過多，請稍後再試", nil)
+	}
+	slot.refs++
+	l.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, maxQueueWait)
+	defer cancel()
+	select {
+	case slot.sem <- struct{}{}:
+	case <-ctx.Done():
+		l.unref(key, slot)
+		return nil, newError(CodeHostBusy, "等待連接名額超時", ctx.Err())
+	}
+
+	return func() {
+		<-slot.sem
+		l.unref(key, slot)
+	}, nil
+}
+
+// unref 減少目標的引用計數，沒有請求在使用或等待時移除該目標，避免 map 無限增長
+func (l *hostLimiter) unref(key string, slot *hostSlot) {
+	l.mu.Lock()
+	slot.refs--
+	if slot.refs == 0 {
+		delete(l.slots, key)
+	}
+	l.mu.Unlock()
+}
@@ -0,0 +1,211 @@
+package mcstatus
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// loginProbeName 是登入檢查時使用的玩家名稱
+const loginProbeName = "MCStatusProbe"
+
+// loginTimeout 是登入檢查的總時限
+const loginTimeout = 5 * time.Second
+
+// maxLoginPacketLength 是登入階段接受的最大數據包長度
+const maxLoginPacketLength = 1 << 20
+
+// 登入階段伺服器發送的數據包 ID
+const (
+	loginDisconnect     = 0x00
+	loginEncryption     = 0x01
+	loginSuccess        = 0x02
+	loginSetCompression = 0x03
+)
+
+// 登入數據包格式發生變化的協議版本
+// 快照版本的協議號從 0x40000000 開始，大於以下所有版本，因此按最新格式處理
+const (
+	protocol1_19   = 759 // 加入簽名數據
+	protocol1_19_1 = 760 // 加入可選的玩家 UUID
+	protocol1_19_3 = 761 // 移除簽名數據
+	protocol1_20_2 = 764 // 玩家 UUID 變為必填
+	protocol1_20_5 = 766 // 加密請求加入是否需要驗證的標記
+)
+
+// LoginCheck 是登入檢查的結果，字段為 null 表示無法推斷
+type LoginCheck struct {
+	OnlineMode  *bool  `json:"online_mode"`          // 是否為正版驗證模式
+	Whitelisted *bool  `json:"whitelisted"`          // 是否啟用了白名單
+	Disconnect  string `json:"disconnect,omitempty"` // 伺服器斷開連接時給出的原因
+	Error       string `json:"error,omitempty"`      // 檢查失敗的原因
+}
+
+// checkLogin 開始登入流程但不完成驗證，根據伺服器的第一個回應推斷正版驗證模式和白名單
+//   - 加密請求：正版驗證模式（1.20.5+ 可能標記為不需要驗證），白名單在驗證後才檢查，因此無法推斷
+//   - 設置壓縮或登入成功：離線模式且沒有白名單
+//   - 斷開連接且原因提到白名單：離線模式且啟用了白名單（正版模式下白名單在驗證後才檢查）
+//
+// 時限不超過 loginTimeout 和 ctx 的剩餘時間，ctx 被取消時立即中止
+func checkLogin(ctx context.Context, dialer Dialer, ip net.IP, port int, host string, protocol int) *LoginCheck {
+	result := &LoginCheck{}
+	if protocol < 4 {
+		result.Error = "伺服器的協議版本不支援登入檢查"
+		return result
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, loginTimeout)
+	defer cancel()
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(ip.String(), strconv.Itoa(port)))
+	if err != nil {
+		result.Error = "連接伺服器失敗"
+		return result
+	}
+	defer conn.Close()
+	deadline, _ := ctx.Deadline()
+	conn.SetDeadline(deadline)
+	stop := context.AfterFunc(ctx, func() { conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	if err := sendHandshakePacket(conn, host, uint16(port), int32(protocol), handshakeLogin); err != nil {
+		result.Error = "發送握手數據包失敗"
+		return result
+	}
+	loginStart := getPacketBuffer()
+	defer loginStart.release()
+	writeLoginStart(loginStart, protocol)
+	if err := sendPacket(conn, loginStart.Bytes()); err != nil {
+		result.Error = "發送登入數據包失敗"
+		return result
+	}
+
+	reader := getReader(conn)
+	defer putReader(reader)
+	packetID, data, err := readLoginPacket(reader)
+	if err != nil {
+		result.Error = "讀取登入回應失敗"
+		return result
+	}
+
+	switch packetID {
+	case loginEncryption:
+		online := shouldAuthenticate(data, protocol)
+		result.OnlineMode = &online
+	case loginSuccess, loginSetCompression:
+		online, whitelisted := false, false
+		result.OnlineMode = &online
+		result.Whitelisted = &whitelisted
+	case loginDisconnect:
+		raw, _ := readString(bytes.NewReader(data))
+		result.Disconnect = disconnectText(raw)
+		if isWhitelistMessage(raw) {
+			online, whitelisted := false, true
+			result.OnlineMode = &online
+			result.Whitelisted = &whitelisted
+		}
+	}
+	return result
+}
+
+// writeLoginStart 根據協議版本構建登入開始數據包
+func writeLoginStart(packet *PacketBuffer, protocol int) {
+	packet.WriteVarInt(0x00) // Login start packet ID
+	packet.WriteString(loginProbeName)
+	switch {
+	case protocol >= protocol1_20_2:
+		packet.buffer.Write(offlineUUID(loginProbeName))
+	case protocol >= protocol1_19_3:
+		packet.buffer.WriteByte(0) // 不附帶玩家 UUID
+	case protocol >= protocol1_19_1:
+		packet.buffer.WriteByte(0) // 不附帶簽名數據
+		packet.buffer.WriteByte(0) // 不附帶玩家 UUID
+	case protocol >= protocol1_19:
+		packet.buffer.WriteByte(0) // 不附帶簽名數據
+	}
+}
+
+// offlineUUID 計算離線模式下玩家名稱對應的 UUID（版本 3）
+func offlineUUID(name string) []byte {
+	sum := md5.Sum([]byte("OfflinePlayer:" + name))
+	sum[6] = sum[6]&0x0f | 0x30
+	sum[8] = sum[8]&0x3f | 0x80
+	return sum[:]
+}
+
+// readLoginPacket 讀取一個未壓縮的數據包，返回數據包 ID 和內容
+func readLoginPacket(r *bufio.Reader) (int32, []byte, error) {
+	length, err := ReadVarInt(r)
+	if err != nil {
+		return 0, nil, fmt.Errorf("讀取數據包長度失敗: %w", err)
+	}
+	if length <= 0 || length > maxLoginPacketLength {
+		return 0, nil, fmt.Errorf("無效的數據包長度: %d", length)
+	}
+	packet := make([]byte, length)
+	if _, err := io.ReadFull(r, packet); err != nil {
+		return 0, nil, fmt.Errorf("讀取數據包失敗: %w", err)
+	}
+	body := bytes.NewReader(packet)
+	packetID, err := ReadVarInt(body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("讀取數據包 ID 失敗: %w", err)
+	}
+	return packetID, packet[len(packet)-body.Len():], nil
+}
+
+// shouldAuthenticate 解析加密請求，判斷客戶端是否需要通過 Mojang 驗證
+// 1.20.5 之前的加密請求總是表示正版驗證模式
+func shouldAuthenticate(data []byte, protocol int) bool {
+	if protocol < protocol1_20_5 {
+		return true
+	}
+	r := bytes.NewReader(data)
+	for i := 0; i < 3; i++ { // 伺服器 ID、公鑰、驗證令牌
+		if _, err := readString(r); err != nil {
+			return true
+		}
+	}
+	b, err := r.ReadByte()
+	return err != nil || b != 0
+}
+
+// readString 讀取一個以 VarInt 長度為前綴的字符串或字節數組
+func readString(r *bytes.Reader) (string, error) {
+	length, err := ReadVarInt(r)
+	if err != nil {
+		return "", err
+	}
+	if length < 0 || int(length) > r.Len() {
+		return "", io.ErrUnexpectedEOF
+	}
+	buf := make([]byte, length)
+	_, err = io.ReadFull(r, buf)
+	return string(buf), err
+}
+
+// disconnectText 將斷開連接原因的 JSON 文本組件轉換為純文本，無法解析時原樣返回
+func disconnectText(raw string) string {
+	var reason ChatComponent
+	if err := json.Unmarshal([]byte(raw), &reason); err != nil {
+		return raw
+	}
+	if text := RenderPlainText(reason.Flatten()); text != "" {
+		return text
+	}
+	return raw
+}
+
+// isWhitelistMessage 判斷斷開連接的原因是否為不在白名單中
+// 原版使用翻譯鍵 multiplayer.disconnect.not_whitelisted，舊版本和插件使用 "white-listed" 等英文消息
+func isWhitelistMessage(raw string) bool {
+	lower := strings.ToLower(raw)
+	return strings.Contains(lower, "whitelist") || strings.Contains(lower, "white-list")
+}
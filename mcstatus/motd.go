@@ -0,0 +1,380 @@
+package mcstatus
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+)
+
+// MOTDComponent 表示 MOTD 中一段具有相同格式的文本
+type MOTDComponent struct {
+	Text          string `json:"text"`
+	Color         string `json:"color,omitempty"`         // 顏色名稱（例如 "gold"）或十六進制顏色（例如 "#ff5555"）
+	Bold          bool   `json:"bold,omitempty"`          // 粗體
+	Italic        bool   `json:"italic,omitempty"`        // 斜體
+	Underlined    bool   `json:"underlined,omitempty"`    // 底線
+	Strikethrough bool   `json:"strikethrough,omitempty"` // 刪除線
+	Obfuscated    bool   `json:"obfuscated,omitempty"`    // 亂碼（隨機字符）
+}
+
+// legacyColors 是舊版格式代碼到顏色名稱的對應表
+var legacyColors = map[rune]string{
+	'0': "black",
+	'1': "dark_blue",
+	'2': "dark_green",
+	'3': "dark_aqua",
+	'4': "dark_red",
+	'5': "dark_purple",
+	'6': "gold",
+	'7': "gray",
+	'8': "dark_gray",
+	'9': "blue",
+	'a': "green",
+	'b': "aqua",
+	'c': "red",
+	'd': "light_purple",
+	'e': "yellow",
+	'f': "white",
+}
+
+// colorHex 是 Minecraft 顏色名稱到十六進制顏色的對應表
+var colorHex = map[string]string{
+	"black":        "#000000",
+	"dark_blue":    "#0000aa",
+	"dark_green":   "#00aa00",
+	"dark_aqua":    "#00aaaa",
+	"dark_red":     "#aa0000",
+	"dark_purple":  "#aa00aa",
+	"gold":         "#ffaa00",
+	"gray":         "#aaaaaa",
+	"dark_gray":    "#555555",
+	"blue":         "#5555ff",
+	"green":        "#55ff55",
+	"aqua":         "#55ffff",
+	"red":          "#ff5555",
+	"light_purple": "#ff55ff",
+	"yellow":       "#ffff55",
+	"white":        "#ffffff",
+}
+
+// ansiColors 是 Minecraft 顏色名稱到 ANSI 前景色代碼的對應表
+var ansiColors = map[string]string{
+	"black":        "30",
+	"dark_blue":    "34",
+	"dark_green":   "32",
+	"dark_aqua":    "36",
+	"dark_red":     "31",
+	"dark_purple":  "35",
+	"gold":         "33",
+	"gray":         "37",
+	"dark_gray":    "90",
+	"blue":         "94",
+	"green":        "92",
+	"aqua":         "96",
+	"red":          "91",
+	"light_purple": "95",
+	"yellow":       "93",
+	"white":        "97",
+}
+
+// ParseLegacyText 將包含 § 格式代碼的文本解析為格式化的文本片段列表
+func ParseLegacyText(s string) []MOTDComponent {
+	return parseLegacyText(s, MOTDComponent{})
+}
+
+// parseLegacyText 以 base 為初始格式解析文本，§r 會將格式重置為 base
+func parseLegacyText(s string, base MOTDComponent) []MOTDComponent {
+	var components []MOTDComponent
+	var text strings.Builder
+	current := base
+
+	// flush 將當前累積的文本作為一個片段輸出
+	flush := func() {
+		if text.Len() == 0 {
+			return
+		}
+		c := current
+		c.Text = text.String()
+		components = append(components, c)
+		text.Reset()
+	}
+
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '§' || i+1 >= len(runes) {
+			text.WriteRune(runes[i])
+			continue
+		}
+
+		code := toLowerRune(runes[i+1])
+
+		// BungeeCord 的十六進制顏色格式：§x§R§R§G§G§B§B
+		if code == 'x' {
+			if hex, ok := parseLegacyHex(runes[i+2:]); ok {
+				flush()
+				current = MOTDComponent{Color: "#" + hex}
+				i += 13
+				continue
+			}
+		}
+
+		if color, ok := legacyColors[code]; ok {
+			// 顏色代碼會同時重置所有格式
+			flush()
+			current = MOTDComponent{Color: color}
+			i++
+			continue
+		}
+
+		switch code {
+		case 'k', 'l', 'm', 'n', 'o':
+			flush()
+			setLegacyFormat(&current, code)
+		case 'r':
+			flush()
+			current = base
+		default:
+			// 未知的格式代碼按原樣保留
+			text.WriteRune(runes[i])
+			continue
+		}
+		i++
+	}
+	flush()
+
+	return components
+}
+
+// setLegacyFormat 根據格式代碼設置片段的格式
+func setLegacyFormat(c *MOTDComponent, code rune) {
+	switch code {
+	case 'k':
+		c.Obfuscated = true
+	case 'l':
+		c.Bold = true
+	case 'm':
+		c.Strikethrough = true
+	case 'n':
+		c.Underlined = true
+	case 'o':
+		c.Italic = true
+	}
+}
+
+// parseLegacyHex 解析 §x 之後的 6 組 §<hex> 代碼，返回小寫的十六進制顏色
+func parseLegacyHex(runes []rune) (string, bool) {
+	if len(runes) < 12 {
+		return "", false
+	}
+	var hex strings.Builder
+	for j := 0; j < 12; j += 2 {
+		if runes[j] != '§' || !isHexRune(runes[j+1]) {
+			return "", false
+		}
+		hex.WriteRune(toLowerRune(runes[j+1]))
+	}
+	return hex.String(), true
+}
+
+// isHexRune 判斷字符是否為十六進制數字
+func isHexRune(r rune) bool {
+	r = toLowerRune(r)
+	return (r >= '0' && r <= '9') || (r >= 'a' && r <= 'f')
+}
+
+// toLowerRune 將 ASCII 大寫字母轉換為小寫
+func toLowerRune(r rune) rune {
+	if r >= 'A' && r <= 'Z' {
+		return r + ('a' - 'A')
+	}
+	return r
+}
+
+// RenderHTML 將文本片段渲染為帶有內聯樣式的 HTML，所有文本都經過轉義，可直接嵌入網頁
+// 亂碼片段會帶有 mc-obfuscated class，以便前端自行實現動畫效果
+func RenderHTML(components []MOTDComponent) string {
+	var b strings.Builder
+	for _, c := range components {
+		var styles []string
+		if hex := resolveColor(c.Color); hex != "" {
+			styles = append(styles, "color:"+hex)
+		}
+		if c.Bold {
+			styles = append(styles, "font-weight:bold")
+		}
+		if c.Italic {
+			styles = append(styles, "font-style:italic")
+		}
+		var decorations []string
+		if c.Underlined {
+			decorations = append(decorations, "underline")
+		}
+		if c.Strikethrough {
+			decorations = append(decorations, "line-through")
+		}
+		if len(decorations) > 0 {
+			styles = append(styles, "text-decoration:"+strings.Join(decorations, " "))
+		}
+
+		b.WriteString("<span")
+		if c.Obfuscated {
+			b.WriteString(` class="mc-obfuscated"`)
+		}
+		if len(styles) > 0 {
+			b.WriteString(` style="`)
+			b.WriteString(strings.Join(styles, ";"))
+			b.WriteString(`"`)
+		}
+		b.WriteString(">")
+		b.WriteString(strings.ReplaceAll(html.EscapeString(c.Text), "\n", "<br>"))
+		b.WriteString("</span>")
+	}
+	return b.String()
+}
+
+// resolveColor 將顏色名稱或十六進制顏色轉換為 CSS 顏色，無效的顏色返回空字符串
+func resolveColor(color string) string {
+	if hex, ok := colorHex[color]; ok {
+		return hex
+	}
+	if len(color) == 7 && color[0] == '#' {
+		for _, r := range color[1:] {
+			if !isHexRune(r) {
+				return ""
+			}
+		}
+		return strings.ToLower(color)
+	}
+	return ""
+}
+
+// RenderPlainText 將文本片段合併為純文本，去除所有格式並丟棄亂碼片段
+// 每行首尾的空白（通常用於置中）也會被去除
+func RenderPlainText(components []MOTDComponent) string {
+	var b strings.Builder
+	for _, c := range components {
+		if c.Obfuscated {
+			continue
+		}
+		b.WriteString(c.Text)
+	}
+
+	lines := strings.Split(b.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// RenderANSI 將文本片段渲染為帶有 ANSI 轉義序列的終端文本
+// 命名顏色使用標準 16 色代碼，十六進制顏色使用 24 位真彩色代碼
+func RenderANSI(components []MOTDComponent) string {
+	var b strings.Builder
+	for _, c := range components {
+		codes := []string{"0"}
+		if code, ok := ansiColors[c.Color]; ok {
+			codes = append(codes, code)
+		} else if hex := resolveColor(c.Color); hex != "" {
+			var r, g, bl int
+			fmt.Sscanf(hex, "#%02x%02x%02x", &r, &g, &bl)
+			codes = append(codes, fmt.Sprintf("38;2;%d;%d;%d", r, g, bl))
+		}
+		if c.Bold {
+			codes = append(codes, "1")
+		}
+		if c.Italic {
+			codes = append(codes, "3")
+		}
+		if c.Underlined {
+			codes = append(codes, "4")
+		}
+		if c.Strikethrough {
+			codes = append(codes, "9")
+		}
+
+		b.WriteString("\x1b[" + strings.Join(codes, ";") + "m")
+		b.WriteString(StripControl(c.Text))
+	}
+	if b.Len() > 0 {
+		b.WriteString("\x1b[0m")
+	}
+	return b.String()
+}
+
+// StripControl 去除換行符以外的所有控制字符（包括 ESC 和 C1 控制字符）
+// MOTD 和版本名稱由被查詢的伺服器提供，輸出到終端之前必須去除，避免伺服器注入 CSI 或 OSC 等轉義序列
+func StripControl(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r != '\n' && unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// RenderLegacy 將文本片段渲染為使用 § 格式代碼的舊版格式字符串
+// 每個片段都以顏色代碼（沒有顏色時為 §r）開頭，十六進制顏色使用 §x 格式
+func RenderLegacy(components []MOTDComponent) string {
+	var b strings.Builder
+	for i, c := range components {
+		// 第一個片段沒有顏色時不需要重置
+		if i > 0 || c.Color != "" {
+			b.WriteString(legacyColorCode(c.Color))
+		}
+		if c.Obfuscated {
+			b.WriteString("§k")
+		}
+		if c.Bold {
+			b.WriteString("§l")
+		}
+		if c.Strikethrough {
+			b.WriteString("§m")
+		}
+		if c.Underlined {
+			b.WriteString("§n")
+		}
+		if c.Italic {
+			b.WriteString("§o")
+		}
+		b.WriteString(c.Text)
+	}
+	return b.String()
+}
+
+// legacyColorCode 返回顏色對應的 § 格式代碼，無效的顏色返回重置代碼 §r
+func legacyColorCode(color string) string {
+	for code, name := range legacyColors {
+		if name == color {
+			return "§" + string(code)
+		}
+	}
+	hex := resolveColor(color)
+	if hex == "" {
+		return "§r"
+	}
+	var b strings.Builder
+	b.WriteString("§x")
+	for _, r := range hex[1:] {
+		b.WriteString("§" + string(r))
+	}
+	return b.String()
+}
+
+// SplitLines 按換行符將文本片段分割為多行，每行保留原有的格式
+func SplitLines(components []MOTDComponent) [][]MOTDComponent {
+	lines := [][]MOTDComponent{nil}
+	for _, c := range components {
+		parts := strings.Split(c.Text, "\n")
+		for i, part := range parts {
+			if i > 0 {
+				lines = append(lines, nil)
+			}
+			if part != "" {
+				c.Text = part
+				lines[len(lines)-1] = append(lines[len(lines)-1], c)
+			}
+		}
+	}
+	return lines
+}
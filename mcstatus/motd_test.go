@@ -0,0 +1,34 @@
+package mcstatus
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderANSIStripsControl(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "OSC 設置標題", text: "A\x1b]0;pwned\x07B", want: "A]0;pwnedB"},
+		{name: "OSC 8 超鏈接", text: "\x1b]8;;https://example.com\x1b\\點擊\x1b]8;;\x1b\\", want: "]8;;https://example.com\\點擊]8;;\\"},
+		{name: "CSI 清屏", text: "\x1b[2J\x1b[Hhello", want: "[2J[Hhello"},
+		{name: "C1 控制字符", text: "a\u009b31mb\u009dc", want: "a31mbc"},
+		{name: "C0 控制字符", text: "a\rb\bc\x00d\x7f", want: "abcd"},
+		{name: "保留換行", text: "第一行\n第二行", want: "第一行\n第二行"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RenderANSI([]MOTDComponent{{Text: tt.text, Color: "gold"}})
+			want := "\x1b[0;33m" + tt.want + "\x1b[0m"
+			if got != want {
+				t.Errorf("RenderANSI(%q) = %q，應為 %q", tt.text, got, want)
+			}
+			// 除了自身輸出的 SGR 代碼以外不應包含任何 ESC
+			if n := strings.Count(got, "\x1b"); n != 2 {
+				t.Errorf("RenderANSI(%q) 包含 %d 個 ESC，應為 2 個", tt.text, n)
+			}
+		})
+	}
+}
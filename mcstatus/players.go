@@ -0,0 +1,33 @@
+package mcstatus
+
+import "regexp"
+
+// anonymousPlayerName 是 1.19 起玩家選擇不在伺服器列表中顯示時使用的名稱
+const anonymousPlayerName = "Anonymous Player"
+
+// nilUUID 是匿名玩家和插件插入的假玩家常用的全零 UUID
+const nilUUID = "00000000-0000-0000-0000-000000000000"
+
+var (
+	usernamePattern = regexp.MustCompile(`^[A-Za-z0-9_]{3,16}$`)
+	uuidPattern     = regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{12}$`)
+)
+
+// isRealSample 判斷玩家列表樣本是否全部為真實玩家
+// 匿名玩家、全零或無效的 UUID、不符合用戶名規則的名稱（插件常用樣本顯示懸停文本）以及重複的 UUID 都視為非真實玩家
+func isRealSample(status *ServerStatus) bool {
+	seen := make(map[string]bool, len(status.Players.Sample))
+	for _, p := range status.Players.Sample {
+		if p.Name == anonymousPlayerName || p.ID == nilUUID {
+			return false
+		}
+		if !usernamePattern.MatchString(p.Name) || !uuidPattern.MatchString(p.ID) {
+			return false
+		}
+		if seen[p.ID] {
+			return false
+		}
+		seen[p.ID] = true
+	}
+	return true
+}
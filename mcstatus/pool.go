@@ -0,0 +1,59 @@
+package mcstatus
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"sync"
+)
+
+// maxPooledBufferSize 是放回池中的緩衝區的最大容量，偶爾出現的超大回應不應一直佔用內存
+const maxPooledBufferSize = 64 << 10
+
+// 查詢時重複使用的緩衝區，批量查詢和監控時可以大幅減少每次查詢的內存分配
+var (
+	packetBuffers = sync.Pool{New: func() any { return new(PacketBuffer) }}
+	readBuffers   = sync.Pool{New: func() any { return new(bytes.Buffer) }}
+	bufReaders    = sync.Pool{New: func() any { return bufio.NewReader(nil) }}
+)
+
+// getPacketBuffer 從池中取出一個空的 PacketBuffer，用完後需要調用 release
+func getPacketBuffer() *PacketBuffer {
+	return packetBuffers.Get().(*PacketBuffer)
+}
+
+// release 清空緩衝區並放回池中，之後不能再使用 Bytes 返回的切片
+func (pb *PacketBuffer) release() {
+	if pb.buffer.Cap() > maxPooledBufferSize {
+		return
+	}
+	pb.buffer.Reset()
+	packetBuffers.Put(pb)
+}
+
+// getReadBuffer 從池中取出一個空的讀取緩衝區
+func getReadBuffer() *bytes.Buffer {
+	return readBuffers.Get().(*bytes.Buffer)
+}
+
+// putReadBuffer 將讀取緩衝區放回池中，之後不能再使用從中讀取的數據
+func putReadBuffer(b *bytes.Buffer) {
+	if b.Cap() > maxPooledBufferSize {
+		return
+	}
+	b.Reset()
+	readBuffers.Put(b)
+}
+
+// getReader 從池中取出一個讀取 r 的 bufio.Reader
+func getReader(r io.Reader) *bufio.Reader {
+	br := bufReaders.Get().(*bufio.Reader)
+	br.Reset(r)
+	return br
+}
+
+// putReader 將 bufio.Reader 放回池中，並解除對連接的引用
+func putReader(br *bufio.Reader) {
+	br.Reset(nil)
+	bufReaders.Put(br)
+}
@@ -0,0 +1,231 @@
+package mcstatus
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Mojang 會話伺服器的設定
+const (
+	profileCacheTTL     = time.Hour        // 玩家資料的緩存時間
+	profileNotFoundTTL  = 10 * time.Minute // 查無此玩家時的緩存時間
+	profileRateLimitTTL = time.Minute      // 被限流且沒有 Retry-After 時暫停查詢的時間
+	profileConcurrency  = 4                // 同時進行的最大查詢數
+	maxEnrichedPlayers  = 12               // 每次最多查詢的玩家數，與原版伺服器的樣本大小相同
+	maxCachedProfiles   = 10000            // 緩存的最大玩家數
+)
+
+// sessionServerURL 是 Mojang 會話伺服器查詢玩家資料的地址
+var sessionServerURL = "https://sessionserver.mojang.com/session/minecraft/profile/"
+
+var profileClient = &http.Client{Timeout: 5 * time.Second}
+
+// errProfileRateLimited 表示會話伺服器正在限流，暫時不發送請求
+var errProfileRateLimited = errors.New("Mojang 會話伺服器限流中")
+
+// PlayerProfile 是從 Mojang 會話伺服器獲取的玩家資料
+type PlayerProfile struct {
+	Name    string `json:"name"`               // 大小寫正確的玩家名稱
+	SkinURL string `json:"skin_url,omitempty"` // 皮膚圖片地址
+	CapeURL string `json:"cape_url,omitempty"` // 披風圖片地址
+	Slim    bool   `json:"slim,omitempty"`     // 是否使用纖細（Alex）模型
+}
+
+// cachedProfile 緩存的玩家資料，profile 為 nil 表示查無此玩家
+type cachedProfile struct {
+	profile *PlayerProfile
+	expires time.Time
+}
+
+// profileCache 緩存玩家資料，並在被限流時暫停查詢
+type profileCache struct {
+	mu           sync.Mutex
+	profiles     map[string]cachedProfile
+	blockedUntil time.Time
+	lastPrune    time.Time
+}
+
+var profiles = &profileCache{profiles: make(map[string]cachedProfile)}
+
+// EnrichPlayers 通過 Mojang 會話伺服器查詢玩家列表樣本中各玩家的資料
+// 只查詢正版玩家的 UUID（版本 4），查詢失敗的玩家保持不變
+// 樣本由伺服器控制，最多只查詢前 maxEnrichedPlayers 個正版玩家，避免一次請求發出大量查詢
+func EnrichPlayers(ctx context.Context, status *ServerStatus) {
+	sem := make(chan struct{}, profileConcurrency)
+	var wg sync.WaitGroup
+	queried := 0
+	for i := range status.Players.Sample {
+		if queried == maxEnrichedPlayers {
+			break
+		}
+		player := &status.Players.Sample[i]
+		id, ok := onlineUUID(player.ID)
+		if !ok {
+			continue
+		}
+		queried++
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			profile, err := profiles.lookup(ctx, id)
+			if err != nil {
+				log.Printf("查詢玩家資料 %s 失敗: %v", id, err)
+				return
+			}
+			player.Profile = profile
+		}()
+	}
+	wg.Wait()
+}
+
+// onlineUUID 檢查字符串是否為正版玩家的 UUID（版本 4），返回去除連字符的小寫形式
+// 離線模式的 UUID 是版本 3，插件插入的假玩家通常使用全零 UUID
+func onlineUUID(s string) (string, bool) {
+	id := strings.ToLower(strings.ReplaceAll(s, "-", ""))
+	if len(id) != 32 || id[12] != '4' {
+		return "", false
+	}
+	for _, r := range id {
+		if !isHexRune(r) {
+			return "", false
+		}
+	}
+	return id, true
+}
+
+// lookup 查詢玩家資料，優先使用緩存，查無此玩家時返回 nil
+func (c *profileCache) lookup(ctx context.Context, id string) (*PlayerProfile, error) {
+	now := time.Now()
+	c.mu.Lock()
+	entry, ok := c.profiles[id]
+	blocked := now.Before(c.blockedUntil)
+	c.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.profile, nil
+	}
+	if blocked {
+		return nil, errProfileRateLimited
+	}
+
+	profile, retryAfter, err := fetchProfile(ctx, id)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch {
+	case retryAfter > 0:
+		c.blockedUntil = now.Add(retryAfter)
+	case err == nil && profile == nil:
+		c.store(now, id, cachedProfile{expires: now.Add(profileNotFoundTTL)})
+	case err == nil:
+		c.store(now, id, cachedProfile{profile: profile, expires: now.Add(profileCacheTTL)})
+	}
+	return profile, err
+}
+
+// store 保存玩家資料，每隔 profileNotFoundTTL 清理過期的條目，緩存已滿時隨機移除條目，調用者需持有 c.mu
+func (c *profileCache) store(now time.Time, id string, entry cachedProfile) {
+	if now.Sub(c.lastPrune) >= profileNotFoundTTL {
+		c.lastPrune = now
+		for k, e := range c.profiles {
+			if !now.Before(e.expires) {
+				delete(c.profiles, k)
+			}
+		}
+	}
+	for k := range c.profiles {
+		if len(c.profiles) < maxCachedProfiles {
+			break
+		}
+		delete(c.profiles, k)
+	}
+	c.profiles[id] = entry
+}
+
+// fetchProfile 從會話伺服器獲取玩家資料
+// 被限流時返回需要等待的時間，查無此玩家時返回 nil
+func fetchProfile(ctx context.Context, id string) (*PlayerProfile, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sessionServerURL+id, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	resp, err := profileClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNoContent, http.StatusNotFound:
+		return nil, 0, nil
+	case http.StatusTooManyRequests:
+		retryAfter := profileRateLimitTTL
+		if s, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && s > 0 {
+			retryAfter = time.Duration(s) * time.Second
+		}
+		return nil, retryAfter, errProfileRateLimited
+	default:
+		return nil, 0, fmt.Errorf("會話伺服器返回 %s", resp.Status)
+	}
+
+	var body struct {
+		Name       string `json:"name"`
+		Properties []struct {
+			Name  string `json:"name"`
+			Value string `json:"value"`
+		} `json:"properties"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, 0, fmt.Errorf("解析玩家資料失敗: %w", err)
+	}
+
+	profile := &PlayerProfile{Name: body.Name}
+	for _, p := range body.Properties {
+		if p.Name == "textures" {
+			parseTextures(p.Value, profile)
+		}
+	}
+	return profile, 0, nil
+}
+
+// parseTextures 解析 Base64 編碼的 textures 屬性，填入皮膚和披風地址
+func parseTextures(value string, profile *PlayerProfile) {
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return
+	}
+	var textures struct {
+		Textures struct {
+			Skin *struct {
+				URL      string `json:"url"`
+				Metadata struct {
+					Model string `json:"model"`
+				} `json:"metadata"`
+			} `json:"SKIN"`
+			Cape *struct {
+				URL string `json:"url"`
+			} `json:"CAPE"`
+		} `json:"textures"`
+	}
+	if json.Unmarshal(data, &textures) != nil {
+		return
+	}
+	if skin := textures.Textures.Skin; skin != nil {
+		profile.SkinURL = skin.URL
+		profile.Slim = skin.Metadata.Model == "slim"
+	}
+	if cape := textures.Textures.Cape; cape != nil {
+		profile.CapeURL = cape.URL
+	}
+}
@@ -0,0 +1,205 @@
+package mcstatus
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// nameserverResolver 使用指定的 DNS 伺服器進行解析，而非系統設定
+type nameserverResolver struct {
+	resolver *net.Resolver
+}
+
+// newNameserverResolver 創建一個使用指定 DNS 伺服器的解析器
+// servers 的格式為 "ip" 或 "ip:port"，未指定端口時使用 53
+func newNameserverResolver(servers []string) *nameserverResolver {
+	addrs := make([]string, 0, len(servers))
+	for _, s := range servers {
+		if _, _, err := net.SplitHostPort(s); err != nil {
+			s = net.JoinHostPort(s, "53")
+		}
+		addrs = append(addrs, s)
+	}
+
+	dialer := &net.Dialer{Timeout: 3 * time.Second}
+	return &nameserverResolver{
+		resolver: &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				// 隨機選擇一個 DNS 伺服器，分散查詢壓力
+				return dialer.DialContext(ctx, network, addrs[rand.Intn(len(addrs))])
+			},
+		},
+	}
+}
+
+// LookupIP 解析主機名的 IP 地址
+func (r *nameserverResolver) LookupIP(ctx context.Context, host string) ([]net.IP, time.Duration, error) {
+	ips, err := r.resolver.LookupIP(ctx, "ip", host)
+	return ips, unknownTTL, err
+}
+
+// LookupSRV 查詢指定名稱的 SRV 記錄
+func (r *nameserverResolver) LookupSRV(ctx context.Context, name string) ([]*net.SRV, time.Duration, error) {
+	_, srvs, err := r.resolver.LookupSRV(ctx, "", "", name)
+	return srvs, unknownTTL, err
+}
+
+// dohResolver 通過 DNS-over-HTTPS (RFC 8484) 進行解析
+type dohResolver struct {
+	endpoint string
+	client   *http.Client
+}
+
+// newDoHResolver 創建一個使用指定 DoH 端點的解析器，例如 https://cloudflare-dns.com/dns-query
+func newDoHResolver(endpoint string) *dohResolver {
+	return &dohResolver{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// LookupIP 查詢主機名的 A 和 AAAA 記錄，返回的 TTL 為所有記錄中最小的 TTL
+func (r *dohResolver) LookupIP(ctx context.Context, host string) ([]net.IP, time.Duration, error) {
+	var ips []net.IP
+	var ttl time.Duration
+	var ttlSet bool
+	var lastErr error
+
+	for _, qtype := range []dnsmessage.Type{dnsmessage.TypeA, dnsmessage.TypeAAAA} {
+		answers, err := r.query(ctx, host, qtype)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		for _, ans := range answers {
+			switch body := ans.Body.(type) {
+			case *dnsmessage.AResource:
+				ips = append(ips, net.IP(body.A[:]))
+			case *dnsmessage.AAAAResource:
+				ips = append(ips, net.IP(body.AAAA[:]))
+			default:
+				continue
+			}
+			ttl, ttlSet = minTTL(ttl, ttlSet, ans.Header.TTL), true
+		}
+	}
+
+	if len(ips) == 0 {
+		if lastErr != nil {
+			return nil, 0, lastErr
+		}
+		return nil, 0, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return ips, ttl, nil
+}
+
+// LookupSRV 查詢指定名稱的 SRV 記錄，結果按優先級和權重排序
+func (r *dohResolver) LookupSRV(ctx context.Context, name string) ([]*net.SRV, time.Duration, error) {
+	answers, err := r.query(ctx, name, dnsmessage.TypeSRV)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var srvs []*net.SRV
+	var ttl time.Duration
+	var ttlSet bool
+	for _, ans := range answers {
+		body, ok := ans.Body.(*dnsmessage.SRVResource)
+		if !ok {
+			continue
+		}
+		srvs = append(srvs, &net.SRV{
+			Target:   body.Target.String(),
+			Port:     body.Port,
+			Priority: body.Priority,
+			Weight:   body.Weight,
+		})
+		ttl, ttlSet = minTTL(ttl, ttlSet, ans.Header.TTL), true
+	}
+	if len(srvs) == 0 {
+		return nil, 0, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+
+	sort.Slice(srvs, func(i, j int) bool {
+		if srvs[i].Priority != srvs[j].Priority {
+			return srvs[i].Priority < srvs[j].Priority
+		}
+		return srvs[i].Weight > srvs[j].Weight
+	})
+	return srvs, ttl, nil
+}
+
+// query 向 DoH 端點發送一個 DNS 查詢並返回回答部分
+func (r *dohResolver) query(ctx context.Context, name string, qtype dnsmessage.Type) ([]dnsmessage.Resource, error) {
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+	qname, err := dnsmessage.NewName(name)
+	if err != nil {
+		return nil, fmt.Errorf("無效的域名: %w", err)
+	}
+
+	// RFC 8484 建議使用 ID 0 以便 HTTP 緩存
+	msg := dnsmessage.Message{
+		Header:    dnsmessage.Header{RecursionDesired: true},
+		Questions: []dnsmessage.Question{{Name: qname, Type: qtype, Class: dnsmessage.ClassINET}},
+	}
+	packed, err := msg.Pack()
+	if err != nil {
+		return nil, fmt.Errorf("構建 DNS 查詢失敗: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint, bytes.NewReader(packed))
+	if err != nil {
+		return nil, fmt.Errorf("創建 DoH 請求失敗: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/dns-message")
+	req.Header.Set("Accept", "application/dns-message")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("DoH 請求失敗: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("DoH 伺服器返回狀態碼 %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return nil, fmt.Errorf("讀取 DoH 回應失敗: %w", err)
+	}
+
+	var reply dnsmessage.Message
+	if err := reply.Unpack(body); err != nil {
+		return nil, fmt.Errorf("解析 DoH 回應失敗: %w", err)
+	}
+	if reply.RCode == dnsmessage.RCodeNameError {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	if reply.RCode != dnsmessage.RCodeSuccess {
+		return nil, &net.DNSError{Err: reply.RCode.String(), Name: name, IsTemporary: true}
+	}
+	return reply.Answers, nil
+}
+
+// minTTL 返回當前最小 TTL 與記錄 TTL 中較小的一個，set 為 false 表示 current 尚未設置
+func minTTL(current time.Duration, set bool, recordTTL uint32) time.Duration {
+	ttl := time.Duration(recordTTL) * time.Second
+	if !set || ttl < current {
+		return ttl
+	}
+	return current
+}
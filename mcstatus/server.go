@@ -0,0 +1,539 @@
+// Package mcstatus 提供了查詢 Minecraft 伺服器狀態的功能
+package mcstatus
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// limiter 限制對同一目標伺服器的同時連接數
+var limiter = newHostLimiter(2)
+
+// dnsLookup 負責解析並緩存主機名與 SRV 記錄
+var dnsLookup = newDNSCache(systemResolver{}, time.Minute)
+
+// outboundDialer 用於建立到 Minecraft 伺服器的連接
+var outboundDialer Dialer = &net.Dialer{}
+
+// socks5Proxy 和 bindAddress 記錄當前的出站連接設定，用於構建 outboundDialer
+var (
+	socks5Proxy string
+	bindAddress string
+)
+
+// QueryOptions 定義了單次查詢的可選參數
+type QueryOptions struct {
+	// BindAddress 指定本次查詢出站連接綁定的本地 IP 或網卡名稱，為空時使用全局設定
+	BindAddress string
+	// ProtocolVersion 指定握手時宣告的客戶端協議版本，為 0 時使用 -1（不指定版本）
+	ProtocolVersion int32
+	// IncludeRaw 為 true 時在結果中附帶伺服器返回的原始 JSON
+	IncludeRaw bool
+	// CheckLogin 為 true 時在查詢狀態後開始登入流程，推斷伺服器是否為正版驗證模式及是否啟用了白名單
+	CheckLogin bool
+	// Client 是發起查詢的客戶端（例如 IP 地址），只用於列出正在進行的查詢，不影響查詢結果
+	Client string
+	// Trace 不為 nil 時記錄查詢過程中收發的數據包和各階段耗時
+	Trace *DebugTrace
+	// Timeout 是整個查詢的時限，為 0 時 DNS 解析和連接各最多 5 秒，讀取回應最多 10 秒
+	Timeout time.Duration
+	// Resolver 不為 nil 時使用它解析主機名和 SRV 記錄，代替全局的 DNS 設定，且不緩存結果
+	Resolver Resolver
+	// Dialer 不為 nil 時使用它建立出站連接，代替全局的代理和綁定地址設定
+	Dialer Dialer
+}
+
+// SetMaxConnsPerHost 設置對同一目標伺服器的最大同時連接數，n <= 0 表示不限制
+func SetMaxConnsPerHost(n int) {
+	limiter = newHostLimiter(n)
+}
+
+// SetDNSCacheTTL 設置 DNS 解析結果的默認緩存時間，記錄本身帶有 TTL 時優先使用記錄的 TTL
+// ttl <= 0 表示不緩存
+func SetDNSCacheTTL(ttl time.Duration) {
+	dnsLookup = newDNSCache(dnsLookup.resolver, ttl)
+}
+
+// SetNameservers 使用指定的 DNS 伺服器代替系統解析器，servers 為空時恢復使用系統解析器
+func SetNameservers(servers []string) {
+	if len(servers) == 0 {
+		dnsLookup = newDNSCache(systemResolver{}, dnsLookup.defaultTTL)
+		return
+	}
+	dnsLookup = newDNSCache(newNameserverResolver(servers), dnsLookup.defaultTTL)
+}
+
+// SetDoHEndpoint 使用 DNS-over-HTTPS 端點代替系統解析器
+func SetDoHEndpoint(endpoint string) {
+	dnsLookup = newDNSCache(newDoHResolver(endpoint), dnsLookup.defaultTTL)
+}
+
+// SetSOCKS5Proxy 設置所有出站查詢通過指定的 SOCKS5 代理連接，proxyURL 為空時直接連接
+func SetSOCKS5Proxy(proxyURL string) error {
+	d, err := newOutboundDialer(proxyURL, bindAddress)
+	if err != nil {
+		return err
+	}
+	socks5Proxy = proxyURL
+	outboundDialer = d
+	return nil
+}
+
+// SetBindAddress 設置出站連接綁定的本地 IP 或網卡名稱，addr 為空時由系統選擇
+func SetBindAddress(addr string) error {
+	d, err := newOutboundDialer(socks5Proxy, addr)
+	if err != nil {
+		return err
+	}
+	bindAddress = addr
+	outboundDialer = d
+	return nil
+}
+
+// ServerStatus 定義了從 Minecraft 伺服器接收到的狀態信息結構
+type ServerStatus struct {
+	Version struct {
+		Name     string `json:"name"`     // 伺服器版本名稱
+		Protocol int    `json:"protocol"` // 伺服器協議版本
+	} `json:"version"`
+	Players struct {
+		Max    int `json:"max"`
+		Online int `json:"online"`
+		Sample []struct {
+			Name    string         `json:"name"`
+			ID      string         `json:"id"`
+			Profile *PlayerProfile `json:"profile,omitempty"` // Mojang 玩家資料（僅在請求時提供）
+		} `json:"sample"`
+		SampleIsReal *bool `json:"sample_is_real,omitempty"` // 樣本是否全部為真實玩家（沒有樣本時省略）
+	} `json:"players"`
+	Description  Description `json:"description"`             // 伺服器描述（MOTD）
+	Favicon      string      `json:"favicon"`                 // 伺服器圖標（Base64 編碼）
+	IconHash     string      `json:"icon_hash,omitempty"`     // 伺服器圖標的 SHA-256 哈希值，可用於檢測圖標變化
+	FaviconError string      `json:"favicon_error,omitempty"` // 伺服器圖標無效時的原因
+	Forge        *ForgeInfo  `json:"forge,omitempty"`         // Forge 模組信息（僅 Forge/NeoForge 伺服器）
+
+	CompatibleVersions []string  `json:"compatible_versions,omitempty"` // 與伺服器協議版本相容的遊戲版本
+	Software           *Software `json:"software,omitempty"`            // 推測的伺服器實現
+
+	EnforcesSecureChat  *bool `json:"enforcesSecureChat,omitempty"`  // 伺服器是否強制要求聊天簽名（1.19.1+，未提供時省略）
+	PreventsChatReports *bool `json:"preventsChatReports,omitempty"` // 伺服器是否阻止聊天舉報（No Chat Reports 模組，未提供時省略）
+
+	Raw json.RawMessage `json:"raw,omitempty"` // 伺服器返回的原始 JSON（僅在請求時提供）
+
+	Location *GeoLocation `json:"location,omitempty"` // 伺服器 IP 的地理位置（僅在載入 GeoIP 數據庫時提供）
+	Network  *NetworkInfo `json:"network,omitempty"`  // 伺服器 IP 所屬的自治系統（僅在擴展模式下提供）
+
+	BlockedByMojang *bool           `json:"blocked_by_mojang,omitempty"` // 是否在 Mojang 的封鎖列表中（僅在擴展模式下提供，無法獲取列表時省略）
+	Votifier        *VotifierStatus `json:"votifier,omitempty"`          // Votifier 端口的檢查結果（僅在請求時提供）
+	Login           *LoginCheck     `json:"login,omitempty"`             // 登入檢查的結果（僅在請求時提供）
+
+	// 連接信息，不包含在 JSON 回應中
+	IP        string        `json:"-"` // 實際連接的 IP 地址
+	Port      int           `json:"-"` // 實際連接的端口
+	SRVTarget string        `json:"-"` // SRV 記錄指向的主機，未使用 SRV 記錄時為空
+	Latency   time.Duration `json:"-"` // 建立 TCP 連接所用的時間
+	QueriedAt time.Time     `json:"-"` // 開始查詢的時間
+	Duration  time.Duration `json:"-"` // 從解析地址到收到狀態回應的總時間
+}
+
+// PacketBuffer 用於構建網絡數據包
+type PacketBuffer struct {
+	buffer bytes.Buffer
+}
+
+// NewPacketBuffer 創建一個新的 PacketBuffer 實例
+func NewPacketBuffer() *PacketBuffer {
+	return &PacketBuffer{}
+}
+
+// WriteVarInt 寫入一個 VarInt 到緩衝區
+func (pb *PacketBuffer) WriteVarInt(val int32) error {
+	// 使用棧上的臨時數組存儲編碼結果，避免每次寫入都分配內存
+	var buf [MaxVarIntLen]byte
+	_, err := pb.buffer.Write(AppendVarInt(buf[:0], val))
+	return err
+}
+
+// WriteString 寫入一個字符串到緩衝區
+func (pb *PacketBuffer) WriteString(s string) error {
+	if err := pb.WriteVarInt(int32(len(s))); err != nil {
+		return err
+	}
+	_, err := pb.buffer.WriteString(s)
+	return err
+}
+
+// WriteUnsignedShort 寫入一個無符號短整數到緩衝區
+func (pb *PacketBuffer) WriteUnsignedShort(val uint16) error {
+	return binary.Write(&pb.buffer, binary.BigEndian, val)
+}
+
+// Bytes 返回緩衝區的字節切片
+func (pb *PacketBuffer) Bytes() []byte {
+	return pb.buffer.Bytes()
+}
+
+// GetServerStatus 查詢指定地址的 Minecraft 伺服器狀態
+func GetServerStatus(address string, opts QueryOptions) (*ServerStatus, error) {
+	return GetServerStatusContext(context.Background(), address, opts)
+}
+
+// GetServerStatusContext 查詢指定地址的 Minecraft 伺服器狀態，ctx 被取消時中止查詢
+func GetServerStatusContext(ctx context.Context, address string, opts QueryOptions) (*ServerStatus, error) {
+	address, err := NormalizeAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	status, err := getServerStatus(ctx, address, opts)
+	targets.record(EditionJava, address, err)
+	return status, err
+}
+
+// getServerStatus 實際查詢伺服器狀態
+func getServerStatus(ctx context.Context, address string, opts QueryOptions) (*ServerStatus, error) {
+	log.Printf("開始查詢伺服器狀態: %s", address)
+	start := time.Now()
+	defer inflight.track(EditionJava, address, opts.Client)()
+	trace, done := watchSlowQuery(EditionJava, address, opts.Trace)
+	defer done()
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	// 選擇本次查詢使用的 dialer 和 DNS 解析器
+	dialer := outboundDialer
+	if opts.Dialer != nil {
+		dialer = opts.Dialer
+	} else if opts.BindAddress != "" {
+		d, err := newOutboundDialer(socks5Proxy, opts.BindAddress)
+		if err != nil {
+			return nil, newError(CodeInvalidBindAddress, "設置出站連接失敗", err)
+		}
+		dialer = d
+	}
+	lookup := dnsLookup
+	if opts.Resolver != nil {
+		lookup = newDNSCache(systemResolver{opts.Resolver}, 0)
+	}
+
+	// 解析地址和端口
+	host, portStr, err := net.SplitHostPort(address)
+	hasPort := err == nil
+	if !hasPort {
+		host = address
+		portStr = "25565" // 默認 Minecraft 端口
+	}
+	log.Printf("解析後的地址: %s:%s", host, portStr)
+
+	// 查找端口號
+	port, err := net.LookupPort("tcp", portStr)
+	if err != nil {
+		return nil, newError(CodeInvalidAddress, "無效的端口", err)
+	}
+
+	dnsCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	// 未指定端口時，按照 Minecraft 客戶端的行為查詢 SRV 記錄
+	phaseStart := time.Now()
+	connectHost := host
+	srvTarget := ""
+	srvCached := false
+	if !hasPort {
+		if target, srvPort, found, cached := lookup.lookupMinecraftSRV(dnsCtx, host); found {
+			srvCached = cached
+			connectHost = target
+			port = int(srvPort)
+			portStr = strconv.Itoa(port)
+			srvTarget = target
+			log.Printf("使用 SRV 記錄: %s:%s（來自緩存: %v）", connectHost, portStr, cached)
+		}
+	}
+
+	// 解析 IP 地址
+	ips, cached, err := lookup.lookupIP(dnsCtx, connectHost)
+	trace.phase("dns", phaseStart, err)
+	if err != nil {
+		if errors.Is(err, errNoRecords) {
+			return nil, newError(CodeDNSFailure, "無法找到 IP 地址", nil)
+		}
+		return nil, newError(CodeDNSFailure, "無法解析主機名", err)
+	}
+	log.Printf("解析到的 IP: %v（來自緩存: %v）", ips, cached)
+	trace.dns(srvTarget, srvCached, ips, cached)
+
+	// 等待該目標的連接名額，避免同時對同一伺服器開啟過多連接
+	release, err := limiter.acquire(ctx, strings.ToLower(net.JoinHostPort(host, portStr)))
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// 建立 TCP 連接，依次嘗試所有解析到的地址
+	phaseStart = time.Now()
+	conn, ip, err := dialAny(ctx, dialer, ips, portStr, 5*time.Second)
+	latency := time.Since(phaseStart)
+	trace.phase("dial", phaseStart, err)
+	if err != nil {
+		return nil, newError(classifyDialError(err), "連接伺服器失敗", err)
+	}
+	defer conn.Close()
+	log.Printf("成功建立連接: %s", ip)
+	conn = trace.wrap(conn)
+
+	// 設置連接超時，不超過整體的時限，ctx 被取消時立即中止讀寫
+	deadline := time.Now().Add(10 * time.Second)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	conn.SetDeadline(deadline)
+	stop := context.AfterFunc(ctx, func() { conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	// 發送握手包
+	phaseStart = time.Now()
+	protocol := opts.ProtocolVersion
+	if protocol == 0 {
+		protocol = -1
+	}
+	if err := sendHandshakePacket(conn, host, uint16(port), protocol, handshakeStatus); err != nil {
+		trace.phase("handshake", phaseStart, err)
+		return nil, newError(classifyReadError(err), "發送握手數據包失敗", err)
+	}
+	log.Println("握手數據包發送成功")
+
+	// 發送狀態請求包
+	err = sendStatusRequestPacket(conn)
+	trace.phase("handshake", phaseStart, err)
+	if err != nil {
+		return nil, newError(classifyReadError(err), "發送狀態請求數據包失敗", err)
+	}
+	log.Println("狀態請求數據包發送成功")
+
+	// 讀取並解析伺服器回應，回應讀入池中的緩衝區，解析完畢後即可重用
+	phaseStart = time.Now()
+	reader := getReader(conn)
+	defer putReader(reader)
+	responseBuf := getReadBuffer()
+	defer putReadBuffer(responseBuf)
+	rawResponse, err := readAndParseResponse(reader, responseBuf)
+	trace.phase("read", phaseStart, err)
+	if err != nil {
+		return nil, newError(classifyReadError(err), "讀取和解析回應失敗", err)
+	}
+	log.Printf("收到原始回應：%s", rawResponse)
+
+	// 解析 JSON 回應
+	var status ServerStatus
+	err = json.Unmarshal(rawResponse, &status)
+	if err != nil {
+		// 如果解析失敗，嘗試只解析描述
+		var fallbackStatus struct {
+			Description ChatComponent `json:"description"`
+		}
+		if err := json.Unmarshal(rawResponse, &fallbackStatus); err != nil {
+			return nil, newError(CodeParseError, "解析 JSON 響應失敗", err)
+		}
+		status.Description.ChatComponent = fallbackStatus.Description
+	}
+
+	// 處理可能的 Unicode 轉義序列
+	unescapeComponent(&status.Description.ChatComponent)
+
+	// 展開描述的組件樹並解析其中的舊版 § 格式代碼
+	status.Description.Components = status.Description.Flatten()
+
+	// 附帶未經處理的原始 JSON
+	if opts.IncludeRaw {
+		status.Raw = json.RawMessage(bytes.Clone(rawResponse))
+	}
+
+	// 根據協議號查找相容的遊戲版本
+	status.CompatibleVersions = VersionsForProtocol(status.Version.Protocol)
+
+	// 解析 Forge 模組信息
+	status.Forge = parseForgeInfo(rawResponse)
+
+	// 推測伺服器實現
+	status.Software = detectSoftware(&status)
+
+	// 檢測樣本中的匿名玩家和插件插入的假玩家
+	if len(status.Players.Sample) > 0 {
+		isReal := isRealSample(&status)
+		status.Players.SampleIsReal = &isReal
+	}
+
+	// 驗證伺服器圖標並計算哈希值
+	if status.Favicon != "" {
+		if hash, err := ValidateFavicon(status.Favicon); err != nil {
+			status.FaviconError = err.Error()
+		} else {
+			status.IconHash = hash
+		}
+	}
+
+	status.IP = ip.String()
+	status.Port = port
+	status.SRVTarget = srvTarget
+	status.Latency = latency
+	status.QueriedAt = start
+	status.Duration = time.Since(start)
+	status.Location = lookupLocation(status.IP)
+
+	// 登入檢查使用新的連接，並宣告伺服器自身的協議版本
+	if opts.CheckLogin {
+		status.Login = checkLogin(ctx, dialer, ip, port, host, status.Version.Protocol)
+	}
+
+	log.Println("成功解析 JSON 響應")
+
+	return &status, nil
+}
+
+// readAndParseResponse 從連接中讀取並解析伺服器回應
+// 返回的 JSON 數據位於 buf 中，在 buf 被重用之前有效
+func readAndParseResponse(reader *bufio.Reader, buf *bytes.Buffer) ([]byte, error) {
+	// 讀取數據包長度
+	packetLength, err := ReadVarInt(reader)
+	if err != nil {
+		return nil, fmt.Errorf("讀取數據包長度失敗: %w", err)
+	}
+	if packetLength <= 0 || packetLength > maxPacketLength {
+		return nil, fmt.Errorf("無效的數據包長度: %d", packetLength)
+	}
+
+	// 讀取數據包 ID
+	packetID, err := ReadVarInt(reader)
+	if err != nil {
+		return nil, fmt.Errorf("讀取數據包 ID 失敗: %w", err)
+	}
+
+	if packetID != 0x00 {
+		return nil, fmt.Errorf("無效的數據包 ID: %d", packetID)
+	}
+
+	// 讀取 JSON 長度
+	jsonLength, err := ReadVarInt(reader)
+	if err != nil {
+		return nil, fmt.Errorf("讀取 JSON 長度失敗: %w", err)
+	}
+	if jsonLength < 0 || jsonLength >= packetLength {
+		return nil, fmt.Errorf("無效的 JSON 長度: %d（數據包長度 %d）", jsonLength, packetLength)
+	}
+
+	// 讀取 JSON 數據
+	buf.Grow(int(jsonLength))
+	jsonData := buf.AvailableBuffer()[:jsonLength]
+	_, err = io.ReadFull(reader, jsonData)
+	if err != nil {
+		return nil, fmt.Errorf("讀取 JSON 數據失敗: %w", err)
+	}
+
+	return jsonData, nil
+}
+
+// maxPacketLength 是協議允許的最大數據包長度（3 字節 VarInt 可表示的最大值），與官方客戶端一致
+const maxPacketLength = 1<<21 - 1
+
+// 握手數據包中的下一個狀態
+const (
+	handshakeStatus = 1 // 查詢狀態
+	handshakeLogin  = 2 // 登入
+)
+
+// sendHandshakePacket 發送握手數據包
+func sendHandshakePacket(conn net.Conn, host string, port uint16, protocol, nextState int32) error {
+	packet := getPacketBuffer()
+	defer packet.release()
+	packet.WriteVarInt(0x00)        // Handshake packet ID
+	packet.WriteVarInt(protocol)    // Protocol version (-1 if unspecified)
+	packet.WriteString(host)        // Server address
+	packet.WriteUnsignedShort(port) // Server port
+	packet.WriteVarInt(nextState)   // Next state (1 for status, 2 for login)
+	return sendPacket(conn, packet.Bytes())
+}
+
+// sendStatusRequestPacket 發送狀態請求數據包
+func sendStatusRequestPacket(conn net.Conn) error {
+	packet := getPacketBuffer()
+	defer packet.release()
+	packet.WriteVarInt(0x00) // Status request packet ID
+	return sendPacket(conn, packet.Bytes())
+}
+
+// sendPacket 發送數據包到連接
+func sendPacket(conn net.Conn, data []byte) error {
+	packet := getPacketBuffer()
+	defer packet.release()
+	packet.WriteVarInt(int32(len(data)))
+	packet.buffer.Write(data)
+	n, err := conn.Write(packet.Bytes())
+	if err != nil {
+		return fmt.Errorf("發送數據包失敗: %w", err)
+	}
+	log.Printf("發送數據包成功，長度: %d 字節", n)
+	return nil
+}
+
+// unescapeComponent 遞歸處理組件樹中所有文本的 Unicode 轉義序列
+func unescapeComponent(c *ChatComponent) {
+	c.Text = unescapeUnicode(c.Text)
+	for i := range c.Extra {
+		unescapeComponent(&c.Extra[i])
+	}
+}
+
+// unescapeUnicode 解碼字符串中殘留的 \uXXXX 轉義序列（部分伺服器會對 MOTD 進行雙重轉義）
+// 連續的轉義序列會交給 encoding/json 一併解碼，從而正確組合 UTF-16 代理對（例如 emoji）
+func unescapeUnicode(s string) string {
+	if !strings.Contains(s, `\u`) {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		// 找出從 i 開始的連續轉義序列
+		j := i
+		for j+6 <= len(s) && isUnicodeEscape(s[j:j+6]) {
+			j += 6
+		}
+		if j > i {
+			var decoded string
+			if err := json.Unmarshal([]byte(`"`+s[i:j]+`"`), &decoded); err == nil {
+				b.WriteString(decoded)
+				i = j
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+	return b.String()
+}
+
+// isUnicodeEscape 判斷字符串是否為一個 \uXXXX 轉義序列
+func isUnicodeEscape(s string) bool {
+	if len(s) != 6 || s[0] != '\\' || s[1] != 'u' {
+		return false
+	}
+	for _, r := range s[2:] {
+		if !isHexRune(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+package mcstatus
+
+import (
+	"expvar"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// slowQueryThreshold 是記錄慢查詢的耗時閾值，0 表示不記錄
+var slowQueryThreshold time.Duration
+
+var (
+	// slowQueries 按版本統計慢查詢的次數
+	slowQueries = expvar.NewMap("slow_queries")
+	// slowQueryPhaseMs 按階段累計慢查詢的耗時（毫秒），除以次數可得各階段的平均耗時
+	slowQueryPhaseMs = expvar.NewMap("slow_query_phase_ms")
+)
+
+// SetSlowQueryThreshold 設置慢查詢的耗時閾值，超過閾值的查詢會記錄各階段的耗時，d <= 0 表示不記錄
+func SetSlowQueryThreshold(d time.Duration) {
+	slowQueryThreshold = max(d, 0)
+}
+
+// watchSlowQuery 在啟用慢查詢記錄時開始計時，返回查詢使用的追蹤和查詢結束時調用的函數
+// 請求了調試信息時沿用原有的追蹤，否則創建一個只記錄耗時的追蹤
+func watchSlowQuery(edition, address string, trace *DebugTrace) (*DebugTrace, func()) {
+	threshold := slowQueryThreshold
+	if threshold <= 0 {
+		return trace, func() {}
+	}
+	if trace == nil {
+		trace = newTimingTrace()
+	}
+	start := time.Now()
+	return trace, func() {
+		elapsed := time.Since(start)
+		if elapsed < threshold {
+			return
+		}
+		recordSlowQuery(edition, address, elapsed, trace)
+	}
+}
+
+// recordSlowQuery 記錄一次慢查詢及各階段的耗時
+// 同一階段出現多次時（例如依次嘗試多個 IP）累計耗時，未計入任何階段的時間（例如等待連接名額）記為 other
+func recordSlowQuery(edition, address string, elapsed time.Duration, trace *DebugTrace) {
+	trace.mu.Lock()
+	var order []string
+	phases := make(map[string]float64)
+	for _, p := range trace.Phases {
+		if _, ok := phases[p.Phase]; !ok {
+			order = append(order, p.Phase)
+		}
+		phases[p.Phase] += p.DurationMs
+	}
+	trace.mu.Unlock()
+
+	other := float64(elapsed.Microseconds()) / 1000
+	parts := make([]string, 0, len(order)+1)
+	for _, name := range order {
+		other -= phases[name]
+		parts = append(parts, fmt.Sprintf("%s %.0fms", name, phases[name]))
+		slowQueryPhaseMs.AddFloat(name, phases[name])
+	}
+	other = max(other, 0)
+	parts = append(parts, fmt.Sprintf("other %.0fms", other))
+	slowQueryPhaseMs.AddFloat("other", other)
+	slowQueries.Add(edition, 1)
+
+	log.Printf("慢查詢: %s 版 %s 耗時 %v（%s）", edition, address, elapsed.Round(time.Millisecond), strings.Join(parts, ", "))
+}
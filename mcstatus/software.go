@@ -0,0 +1,70 @@
+package mcstatus
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Software 是根據狀態回應推測出的伺服器實現
+type Software struct {
+	Name  string `json:"name"`            // 伺服器實現名稱，例如 "Paper"；無法判斷時為 "Unknown"
+	Proxy bool   `json:"proxy,omitempty"` // 是否為 Velocity、BungeeCord 等代理伺服器
+}
+
+// softwareSignature 是版本名稱中的關鍵字與伺服器實現的對應
+type softwareSignature struct {
+	keyword string
+	name    string
+	proxy   bool
+}
+
+// softwareSignatures 按優先順序排列，衍生實現必須排在其上游之前（例如 Purpur 在 Paper 之前）
+var softwareSignatures = []softwareSignature{
+	{"velocity", "Velocity", true},
+	{"waterfall", "Waterfall", true},
+	{"flamecord", "FlameCord", true},
+	{"travertine", "Travertine", true},
+	{"bungeecord", "BungeeCord", true},
+	{"folia", "Folia", false},
+	{"purpur", "Purpur", false},
+	{"pufferfish", "Pufferfish", false},
+	{"paper", "Paper", false},
+	{"spigot", "Spigot", false},
+	{"craftbukkit", "CraftBukkit", false},
+	{"bukkit", "CraftBukkit", false},
+	{"neoforge", "NeoForge", false},
+	{"forge", "Forge", false},
+	{"quilt", "Quilt", false},
+	{"fabric", "Fabric", false},
+	{"sponge", "Sponge", false},
+	{"mohist", "Mohist", false},
+	{"arclight", "Arclight", false},
+}
+
+// vanillaVersionPattern 匹配原版伺服器的版本名稱，例如 "1.20.4" 或 "24w14a"
+var vanillaVersionPattern = regexp.MustCompile(`^(\d+\.\d+(\.\d+)?(-(pre|rc)\d+)?|\d{2}w\d{2}[a-z])$`)
+
+// detectSoftware 根據版本名稱和模組信息推測伺服器實現
+func detectSoftware(status *ServerStatus) *Software {
+	name := strings.ToLower(status.Version.Name)
+	for _, sig := range softwareSignatures {
+		if strings.Contains(name, sig.keyword) {
+			return &Software{Name: sig.name, Proxy: sig.proxy}
+		}
+	}
+
+	// 版本名稱沒有標識時，根據 Forge 模組信息判斷
+	if status.Forge != nil {
+		for _, mod := range status.Forge.Mods {
+			if mod.ID == "neoforge" {
+				return &Software{Name: "NeoForge"}
+			}
+		}
+		return &Software{Name: "Forge"}
+	}
+
+	if vanillaVersionPattern.MatchString(strings.TrimSpace(status.Version.Name)) {
+		return &Software{Name: "Vanilla"}
+	}
+	return &Software{Name: "Unknown"}
+}
@@ -0,0 +1,52 @@
+package mcstatus
+
+// CacheSizes 是各個內部緩存當前的條目數，用於排查內存佔用
+type CacheSizes struct {
+	Status         int `json:"status"`          // 狀態查詢結果
+	DNSAddresses   int `json:"dns_addresses"`   // IP 解析結果
+	DNSSRV         int `json:"dns_srv"`         // SRV 解析結果
+	Profiles       int `json:"profiles"`        // 玩家資料
+	Avatars        int `json:"avatars"`         // 玩家頭像
+	BlockedServers int `json:"blocked_servers"` // Mojang 封鎖列表中的哈希值
+	Targets        int `json:"targets"`         // 按目標統計的查詢結果
+	HostLimiter    int `json:"host_limiter"`    // 正在使用連接名額的目標
+}
+
+// GetCacheSizes 返回各個內部緩存當前的條目數
+func GetCacheSizes() CacheSizes {
+	var s CacheSizes
+
+	c := statuses
+	c.mu.Lock()
+	s.Status = len(c.entries)
+	c.mu.Unlock()
+
+	dns := dnsLookup
+	dns.mu.Lock()
+	s.DNSAddresses = len(dns.ips)
+	s.DNSSRV = len(dns.srvs)
+	dns.mu.Unlock()
+
+	profiles.mu.Lock()
+	s.Profiles = len(profiles.profiles)
+	profiles.mu.Unlock()
+
+	facesMu.Lock()
+	s.Avatars = len(faces)
+	facesMu.Unlock()
+
+	blocklist.mu.Lock()
+	s.BlockedServers = len(blocklist.hashes)
+	blocklist.mu.Unlock()
+
+	targets.mu.Lock()
+	s.Targets = len(targets.targets)
+	targets.mu.Unlock()
+
+	if l := limiter; l != nil {
+		l.mu.Lock()
+		s.HostLimiter = len(l.slots)
+		l.mu.Unlock()
+	}
+	return s
+}
@@ -0,0 +1,138 @@
+package mcstatus
+
+import (
+	"expvar"
+	"sort"
+	"sync"
+	"time"
+)
+
+// 按目標統計查詢結果的滑動窗口，分為若干個一分鐘的分桶
+const (
+	targetStatsBuckets = 15
+	targetStatsBucket  = time.Minute
+)
+
+// codeUnknown 是未分類錯誤在統計中的類別
+const codeUnknown ErrorCode = "UNKNOWN"
+
+// TargetStat 是一個目標在滑動窗口內的查詢統計
+type TargetStat struct {
+	Edition     string            `json:"edition"`
+	Address     string            `json:"address"`
+	Successes   int               `json:"successes"`
+	Failures    int               `json:"failures"`
+	SuccessRate float64           `json:"success_rate"` // 成功次數佔總次數的比例
+	Errors      map[ErrorCode]int `json:"errors"`       // 按錯誤類別統計的失敗次數
+}
+
+// targetBucket 是一分鐘內的查詢結果
+type targetBucket struct {
+	minute    int64 // 分桶開始的時間（Unix 分鐘）
+	successes int
+	failures  map[ErrorCode]int
+}
+
+// targetRecord 是一個目標的分桶環
+type targetRecord struct {
+	edition string
+	address string
+	buckets [targetStatsBuckets]targetBucket
+	last    int64 // 最後一次查詢的時間（Unix 分鐘）
+}
+
+// targetStats 按目標統計查詢的成功率和錯誤類別，用於區分不穩定的伺服器和本服務自身的問題
+type targetStats struct {
+	mu        sync.Mutex
+	targets   map[string]*targetRecord
+	lastPrune int64
+}
+
+var targets = &targetStats{targets: make(map[string]*targetRecord)}
+
+func init() {
+	expvar.Publish("target_stats", expvar.Func(func() any { return TargetStats() }))
+}
+
+// record 記錄一次查詢的結果
+// 地址格式錯誤等未連接到目標的錯誤不計入統計
+func (t *targetStats) record(edition, address string, err error) {
+	code := ErrorCodeOf(err)
+	if code == CodeInvalidAddress || code == CodeInvalidBindAddress {
+		return
+	}
+	if err != nil && code == "" {
+		code = codeUnknown
+	}
+	minute := time.Now().Unix() / int64(targetStatsBucket/time.Second)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	key := cacheKey(edition, address)
+	r, ok := t.targets[key]
+	if !ok {
+		r = &targetRecord{edition: edition, address: address}
+		t.targets[key] = r
+	}
+	r.last = minute
+
+	b := &r.buckets[minute%targetStatsBuckets]
+	if b.minute != minute {
+		*b = targetBucket{minute: minute}
+	}
+	if err == nil {
+		b.successes++
+	} else {
+		if b.failures == nil {
+			b.failures = make(map[ErrorCode]int)
+		}
+		b.failures[code]++
+	}
+
+	// 每分鐘清除一次窗口內沒有查詢的目標
+	if minute != t.lastPrune {
+		t.lastPrune = minute
+		for k, r := range t.targets {
+			if minute-r.last >= targetStatsBuckets {
+				delete(t.targets, k)
+			}
+		}
+	}
+}
+
+// TargetStats 返回窗口內有查詢的所有目標的統計，成功率低的目標排在前面
+func TargetStats() []TargetStat {
+	minute := time.Now().Unix() / int64(targetStatsBucket/time.Second)
+
+	targets.mu.Lock()
+	stats := make([]TargetStat, 0, len(targets.targets))
+	for _, r := range targets.targets {
+		s := TargetStat{Edition: r.edition, Address: r.address, Errors: make(map[ErrorCode]int)}
+		for _, b := range r.buckets {
+			if minute-b.minute >= targetStatsBuckets {
+				continue
+			}
+			s.Successes += b.successes
+			for code, n := range b.failures {
+				s.Failures += n
+				s.Errors[code] += n
+			}
+		}
+		if total := s.Successes + s.Failures; total > 0 {
+			s.SuccessRate = float64(s.Successes) / float64(total)
+			stats = append(stats, s)
+		}
+	}
+	targets.mu.Unlock()
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].SuccessRate != stats[j].SuccessRate {
+			return stats[i].SuccessRate < stats[j].SuccessRate
+		}
+		if stats[i].Address != stats[j].Address {
+			return stats[i].Address < stats[j].Address
+		}
+		return stats[i].Edition < stats[j].Edition
+	})
+	return stats
+}
@@ -0,0 +1,47 @@
+package mcstatus
+
+import (
+	"errors"
+	"io"
+)
+
+// MaxVarIntLen 是 Minecraft VarInt 編碼的最大長度
+const MaxVarIntLen = 5
+
+// ErrVarIntTooLong 表示 VarInt 超過 5 個字節，或第 5 個字節包含超出 32 位的數據
+var ErrVarIntTooLong = errors.New("VarInt 過長")
+
+// AppendVarInt 將 Minecraft VarInt 編碼追加到 buf
+// 負數按 32 位二進制補碼編碼，總是佔用 5 個字節，例如 -1 編碼為 ff ff ff ff 0f
+func AppendVarInt(buf []byte, value int32) []byte {
+	v := uint32(value)
+	for v >= 0x80 {
+		buf = append(buf, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(buf, byte(v))
+}
+
+// ReadVarInt 讀取一個 Minecraft VarInt
+// 與 binary.ReadUvarint 不同，最多只讀取 5 個字節，超出 32 位的編碼返回 ErrVarIntTooLong，
+// 使格式錯誤或惡意的伺服器無法讓讀取無限進行下去
+func ReadVarInt(r io.ByteReader) (int32, error) {
+	var v uint32
+	for i := 0; i < MaxVarIntLen; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			if i > 0 && err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		if i == MaxVarIntLen-1 && b&0xf0 != 0 {
+			return 0, ErrVarIntTooLong
+		}
+		v |= uint32(b&0x7f) << (7 * i)
+		if b&0x80 == 0 {
+			return int32(v), nil
+		}
+	}
+	return 0, ErrVarIntTooLong
+}
@@ -0,0 +1,56 @@
+package mcstatus
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"testing"
+)
+
+func TestVarInt(t *testing.T) {
+	tests := []struct {
+		value   int32
+		encoded []byte
+	}{
+		{0, []byte{0x00}},
+		{1, []byte{0x01}},
+		{127, []byte{0x7f}},
+		{128, []byte{0x80, 0x01}},
+		{255, []byte{0xff, 0x01}},
+		{25565, []byte{0xdd, 0xc7, 0x01}},
+		{2097151, []byte{0xff, 0xff, 0x7f}},
+		{math.MaxInt32, []byte{0xff, 0xff, 0xff, 0xff, 0x07}},
+		{-1, []byte{0xff, 0xff, 0xff, 0xff, 0x0f}},
+		{math.MinInt32, []byte{0x80, 0x80, 0x80, 0x80, 0x08}},
+	}
+	for _, tt := range tests {
+		if got := AppendVarInt(nil, tt.value); !bytes.Equal(got, tt.encoded) {
+			t.Errorf("AppendVarInt(%d) = % x，應為 % x", tt.value, got, tt.encoded)
+		}
+		got, err := ReadVarInt(bytes.NewReader(tt.encoded))
+		if err != nil || got != tt.value {
+			t.Errorf("ReadVarInt(% x) = %d, %v，應為 %d", tt.encoded, got, err, tt.value)
+		}
+	}
+}
+
+func TestReadVarIntErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		encoded []byte
+		want    error
+	}{
+		{name: "沒有數據", encoded: nil, want: io.EOF},
+		{name: "在中途結束", encoded: []byte{0x80, 0x80}, want: io.ErrUnexpectedEOF},
+		{name: "超過 5 個字節", encoded: []byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x01}, want: ErrVarIntTooLong},
+		{name: "第 5 個字節超出 32 位", encoded: []byte{0xff, 0xff, 0xff, 0xff, 0x1f}, want: ErrVarIntTooLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadVarInt(bytes.NewReader(tt.encoded)); !errors.Is(err, tt.want) {
+				t.Errorf("ReadVarInt(% x) 返回錯誤 %v，應為 %v", tt.encoded, err, tt.want)
+			}
+		})
+	}
+}
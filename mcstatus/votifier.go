@@ -0,0 +1,70 @@
+package mcstatus
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultVotifierPort 是 Votifier 的默認端口
+const DefaultVotifierPort = 8192
+
+// votifierTimeout 是連接 Votifier 端口並讀取問候行的時限
+const votifierTimeout = 3 * time.Second
+
+// maxVotifierGreeting 是問候行的最大長度，NuVotifier 帶挑戰字符串的問候行也遠小於此
+// 超過時不再讀取，避免不斷發送數據但沒有換行的端口在時限內佔用內存
+const maxVotifierGreeting = 256
+
+// VotifierStatus 是 Votifier 端口的檢查結果
+type VotifierStatus struct {
+	Port      int    `json:"port"`               // 檢查的端口
+	Reachable bool   `json:"reachable"`          // 是否可以投票，即端口可連接且返回了 Votifier 問候行
+	Version   string `json:"version,omitempty"`  // 問候行中的版本，例如 1.9（Votifier）或 2（NuVotifier）
+	Protocol  int    `json:"protocol,omitempty"` // 投票協議版本：1 為 RSA 加密，2 為 NuVotifier 的 HMAC 簽名
+	Error     string `json:"error,omitempty"`    // 無法投票時的原因
+}
+
+// CheckVotifier 連接伺服器的 Votifier 端口，根據問候行判斷使用的協議版本
+// Votifier 在連接建立後立即發送 "VOTIFIER <版本>"，NuVotifier 的 v2 協議會在版本後附帶挑戰字符串
+func CheckVotifier(ctx context.Context, ip string, port int) *VotifierStatus {
+	result := &VotifierStatus{Port: port}
+
+	ctx, cancel := context.WithTimeout(ctx, votifierTimeout)
+	defer cancel()
+	conn, err := outboundDialer.DialContext(ctx, "tcp", net.JoinHostPort(ip, strconv.Itoa(port)))
+	if err != nil {
+		result.Error = "無法連接到 Votifier 端口"
+		return result
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	line, err := bufio.NewReader(io.LimitReader(conn, maxVotifierGreeting)).ReadString('\n')
+	if err != nil {
+		result.Error = "沒有收到 Votifier 問候行"
+		if len(line) >= maxVotifierGreeting {
+			result.Error = "端口上運行的不是 Votifier"
+		}
+		return result
+	}
+	fields := strings.Fields(line)
+	if len(fields) < 2 || fields[0] != "VOTIFIER" {
+		result.Error = "端口上運行的不是 Votifier"
+		return result
+	}
+
+	result.Reachable = true
+	result.Version = fields[1]
+	result.Protocol = 1
+	if len(fields) >= 3 {
+		result.Protocol = 2
+	}
+	return result
+}
@@ -0,0 +1,62 @@
+package mcstatus
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+)
+
+// serveVotifier 在本地端口上對每個連接寫入 greeting，返回監聽的端口
+func serveVotifier(t *testing.T, greeting []byte) int {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				conn.Write(greeting)
+				// 保持連接直到客戶端關閉
+				conn.Read(make([]byte, 1))
+			}()
+		}
+	}()
+	return ln.Addr().(*net.TCPAddr).Port
+}
+
+func TestCheckVotifier(t *testing.T) {
+	tests := []struct {
+		name      string
+		greeting  []byte
+		reachable bool
+		protocol  int
+		err       string
+	}{
+		{name: "Votifier", greeting: []byte("VOTIFIER 1.9\n"), reachable: true, protocol: 1},
+		{name: "NuVotifier", greeting: []byte("VOTIFIER 2 b1c4a7f3d2e5\n"), reachable: true, protocol: 2},
+		{name: "其他服務", greeting: []byte("SSH-2.0-OpenSSH_9.6\r\n"), err: "端口上運行的不是 Votifier"},
+		{name: "沒有換行的大量數據", greeting: bytes.Repeat([]byte("A"), 1<<20), err: "端口上運行的不是 Votifier"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			port := serveVotifier(t, tt.greeting)
+			start := time.Now()
+			result := CheckVotifier(context.Background(), "127.0.0.1", port)
+			if result.Reachable != tt.reachable || result.Protocol != tt.protocol || result.Error != tt.err {
+				t.Errorf("CheckVotifier = %+v，應為 reachable=%v protocol=%d error=%q", result, tt.reachable, tt.protocol, tt.err)
+			}
+			// 超過長度限制時應立即返回，而不是等到時限結束
+			if d := time.Since(start); d >= votifierTimeout {
+				t.Errorf("CheckVotifier 用了 %v", d)
+			}
+		})
+	}
+}